@@ -68,3 +68,48 @@ func TestGetSortedValuesStrings(t *testing.T) {
 		}
 	}
 }
+
+func TestFailFastMove(t *testing.T) {
+	f := NewFailFast(0)
+	if actualValue, expectedValue := f.Move(0, false), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	// outside of the elements, e.g. after Begin() or End(), modifications are accepted
+	if actualValue, expectedValue := f.Move(1, true), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := f.InSync(1), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := f.Move(1, false), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	defer func() {
+		if r := recover(); r != ErrConcurrentModification {
+			t.Errorf("Got %v expected %v", r, ErrConcurrentModification)
+		}
+	}()
+	f.Move(2, false)
+	t.Errorf("Move at an element of a modified container should panic")
+}
+
+func TestFailFastChecked(t *testing.T) {
+	f := NewFailFast(0)
+	f.Check()
+	if actualValue, expectedValue := f.Modified(1), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := f.Err(), ErrConcurrentModification; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	// the error sticks until the iterator is synchronised again
+	if actualValue, expectedValue := f.Move(0, false), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := f.Move(1, true), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := f.Err(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
@@ -4,6 +4,14 @@
 
 package containers
 
+import "github.com/uncle-gua/gods/utils"
+
+// Groups holds the groups built by GroupBy under their keys, e.g. a hashmap.Map or a treemap.Map.
+type Groups interface {
+	Get(key interface{}) (value interface{}, found bool)
+	Put(key interface{}, value interface{})
+}
+
 // EnumerableWithIndex provides functions for ordered containers whose values can be fetched by an index.
 type EnumerableWithIndex interface {
 	// Each calls the given function once for each element, passing that element's index and value.
@@ -28,6 +36,46 @@ type EnumerableWithIndex interface {
 	// the first (index,value) for which the function is true or -1,nil otherwise
 	// if no element matches the criteria.
 	Find(func(index int, value interface{}) bool) (int, interface{})
+
+	// Fold passes each element of the container to the given function together with the accumulated value,
+	// starting with the initial value, and returns the last value returned by the function (or the initial value).
+	Fold(initial interface{}, f func(accumulator interface{}, index int, value interface{}) interface{}) interface{}
+
+	// Reduce works like Fold, starting with the first element's value as the accumulated value,
+	// and returns nil if the container is empty.
+	Reduce(func(accumulator interface{}, index int, value interface{}) interface{}) interface{}
+
+	// Count returns the number of elements for which the given function returns a true value.
+	Count(func(index int, value interface{}) bool) int
+
+	// GroupBy puts each element into a group stored in the given map under the key returned by the given function.
+	// Groups are new lists of the values in iteration order, the map should not hold any other values.
+	GroupBy(func(index int, value interface{}) interface{}, Groups)
+
+	// Partition returns a new container containing all elements for which the given function returns a true value
+	// and a new container containing the rest of the elements.
+	// The new containers are of the same type as the container.
+	Partition(func(index int, value interface{}) bool) (Container, Container)
+
+	// MinBy returns the first smallest (index,value) according to the given comparator of values
+	// or -1,nil if the container is empty.
+	MinBy(utils.Comparator) (int, interface{})
+
+	// MaxBy returns the first largest (index,value) according to the given comparator of values
+	// or -1,nil if the container is empty.
+	MaxBy(utils.Comparator) (int, interface{})
+
+	// SumInt returns the sum of the numbers returned by the given function for each element.
+	SumInt(func(index int, value interface{}) int) int
+
+	// SumFloat64 returns the sum of the numbers returned by the given function for each element.
+	SumFloat64(func(index int, value interface{}) float64) float64
+
+	// Distinct returns a new container of the same type containing the elements without repeated values,
+	// keeping the first element for each value.
+	// Containers ordered by a comparator of their values compare the values by it, the others compare them
+	// with == (i.e. use them as map keys), so that values that are not comparable (e.g. slices) panic.
+	Distinct() Container
 }
 
 // EnumerableWithKey provides functions for ordered containers whose values whose elements are key/value pairs.
@@ -54,4 +102,44 @@ type EnumerableWithKey interface {
 	// the first (key,value) for which the function is true or nil,nil otherwise if no element
 	// matches the criteria.
 	Find(func(key interface{}, value interface{}) bool) (interface{}, interface{})
+
+	// Fold passes each element of the container to the given function together with the accumulated value,
+	// starting with the initial value, and returns the last value returned by the function (or the initial value).
+	Fold(initial interface{}, f func(accumulator interface{}, key interface{}, value interface{}) interface{}) interface{}
+
+	// Reduce works like Fold, starting with the first element's value as the accumulated value,
+	// and returns nil if the container is empty.
+	Reduce(func(accumulator interface{}, key interface{}, value interface{}) interface{}) interface{}
+
+	// Count returns the number of elements for which the given function returns a true value.
+	Count(func(key interface{}, value interface{}) bool) int
+
+	// GroupBy puts each element into a group stored in the given map under the key returned by the given function.
+	// Groups are new lists of the values in iteration order, the map should not hold any other values.
+	GroupBy(func(key interface{}, value interface{}) interface{}, Groups)
+
+	// Partition returns a new container containing all elements for which the given function returns a true value
+	// and a new container containing the rest of the elements.
+	// The new containers are of the same type as the container.
+	Partition(func(key interface{}, value interface{}) bool) (Container, Container)
+
+	// MinBy returns the first smallest (key,value) according to the given comparator of values
+	// or nil,nil if the container is empty.
+	MinBy(utils.Comparator) (interface{}, interface{})
+
+	// MaxBy returns the first largest (key,value) according to the given comparator of values
+	// or nil,nil if the container is empty.
+	MaxBy(utils.Comparator) (interface{}, interface{})
+
+	// SumInt returns the sum of the numbers returned by the given function for each element.
+	SumInt(func(key interface{}, value interface{}) int) int
+
+	// SumFloat64 returns the sum of the numbers returned by the given function for each element.
+	SumFloat64(func(key interface{}, value interface{}) float64) float64
+
+	// Distinct returns a new container of the same type containing the elements without repeated values,
+	// keeping the first element for each value.
+	// Containers ordered by a comparator of their values compare the values by it, the others compare them
+	// with == (i.e. use them as map keys), so that values that are not comparable (e.g. slices) panic.
+	Distinct() Container
 }
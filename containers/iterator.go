@@ -4,6 +4,76 @@
 
 package containers
 
+import "errors"
+
+// ErrConcurrentModification is reported by an iterator that is moved after its container was structurally modified
+// (i.e. elements were added or removed, or the container was sorted) other than through the iterator itself.
+// Iterators panic with it, checked iterators stop and return it from Err() instead.
+var ErrConcurrentModification = errors.New("containers: container was modified during iteration")
+
+// FailFast holds the state an iterator needs to fail fast, i.e. to detect that its container was structurally
+// modified other than through the iterator. Containers count their structural modifications and their iterators
+// hand that count to the methods below.
+type FailFast struct {
+	modCount int
+	checked  bool
+	err      error
+}
+
+// NewFailFast returns the state of an iterator synchronised with a container's modification count.
+func NewFailFast(modCount int) FailFast {
+	return FailFast{modCount: modCount}
+}
+
+// Check makes the iterator stop and report ErrConcurrentModification by Err() instead of panicking.
+func (f *FailFast) Check() {
+	f.checked = true
+}
+
+// Err returns ErrConcurrentModification if a checked iterator stopped because its container was modified,
+// otherwise nil.
+func (f *FailFast) Err() error {
+	return f.err
+}
+
+// InSync returns true if the container was not modified since the iterator was synchronised with it.
+func (f *FailFast) InSync(modCount int) bool {
+	return f.modCount == modCount
+}
+
+// Sync accepts the current state of the container, e.g. after the iterator was reset.
+func (f *FailFast) Sync(modCount int) {
+	f.modCount = modCount
+	f.err = nil
+}
+
+// Modified returns true if the container was modified since the iterator was synchronised with it.
+// Panics with ErrConcurrentModification, unless the iterator is checked.
+func (f *FailFast) Modified(modCount int) bool {
+	if f.err != nil {
+		return true
+	}
+	if f.modCount == modCount {
+		return false
+	}
+	if !f.checked {
+		panic(ErrConcurrentModification)
+	}
+	f.err = ErrConcurrentModification
+	return true
+}
+
+// Move returns true if the iterator may move from its current state. An iterator outside of its elements, i.e.
+// before the first or past the last one, has no element to lose and accepts the current state of the container.
+// An iterator at an element may only move if the container was not modified, see Modified().
+func (f *FailFast) Move(modCount int, outside bool) bool {
+	if outside {
+		f.Sync(modCount)
+		return true
+	}
+	return !f.Modified(modCount)
+}
+
 // IteratorWithIndex is stateful iterator for ordered containers whose values can be fetched by an index.
 type IteratorWithIndex interface {
 	// Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -72,7 +142,7 @@ type IteratorWithKey interface {
 //
 // Essentially it is the same as IteratorWithIndex, but provides additional:
 //
-// Prev() function to enable traversal in reverse
+// Prev() function to enable traversal in reverse.
 //
 // Last() function to move the iterator to the last element.
 //
@@ -105,7 +175,7 @@ type ReverseIteratorWithIndex interface {
 //
 // Essentially it is the same as IteratorWithKey, but provides additional:
 //
-// Prev() function to enable traversal in reverse
+// Prev() function to enable traversal in reverse.
 //
 // Last() function to move the iterator to the last element.
 type ReverseIteratorWithKey interface {
@@ -0,0 +1,19 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package containers
+
+import "errors"
+
+// ErrInvalid is wrapped by the errors of Validate(), which describe the violated invariant and where it was violated.
+var ErrInvalid = errors.New("containers: invariant violated")
+
+// Validator provides validation of the container's internal invariants, e.g. to detect a corrupted structure
+// caused by an inconsistent comparator as soon as possible instead of by wrong results later on.
+type Validator interface {
+	// Validate checks the invariants of the container's structure and returns an error wrapping ErrInvalid,
+	// which describes the first violated one, or nil if the structure is valid.
+	// Takes linear time in the size of the container.
+	Validate() error
+}
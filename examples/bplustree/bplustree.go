@@ -0,0 +1,65 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"github.com/uncle-gua/gods/trees/bplustree"
+)
+
+// BPlusTreeExample to demonstrate basic usage of BPlusTree
+func main() {
+	tree := bplustree.NewWithIntComparator(3) // empty (keys are of type int)
+
+	tree.Put(1, "x") // 1->x
+	tree.Put(2, "b") // 1->x, 2->b (in order)
+	tree.Put(1, "a") // 1->a, 2->b (in order, replacement)
+	tree.Put(3, "c") // 1->a, 2->b, 3->c (in order)
+	tree.Put(4, "d") // 1->a, 2->b, 3->c, 4->d (in order)
+	tree.Put(5, "e") // 1->a, 2->b, 3->c, 4->d, 5->e (in order)
+
+	fmt.Println(tree)
+	// BPlusTree
+	//         1
+	//     2
+	//         2
+	// 3
+	//         3
+	//     4
+	//         4
+	//         5
+
+	_ = tree.Values() // []interface {}{"a", "b", "c", "d", "e"} (in order)
+	_ = tree.Keys()   // []interface {}{1, 2, 3, 4, 5} (in order)
+
+	// Range scan over the linked leaves
+	for key, value := range tree.RangeSeq(2, 5) {
+		fmt.Println(key, value) // 2 b, 3 c, 4 d
+	}
+
+	tree.Remove(2) // 1->a, 3->c, 4->d, 5->e (in order)
+	fmt.Println(tree)
+	// BPlusTree
+	//     1
+	// 3
+	//     3
+	// 4
+	//     4
+	//     5
+
+	tree.Clear() // empty
+	tree.Empty() // true
+	tree.Size()  // 0
+
+	// Other:
+	tree.Height()            // gets the height of the tree
+	tree.Left()              // gets the left-most (min) leaf
+	tree.LeftKey()           // get the left-most (min) key
+	tree.LeftValue()         // get the left-most (min) key's value
+	tree.Right()             // get the right-most (max) leaf
+	tree.RightKey()          // get the right-most (max) key
+	tree.RightValue()        // get the right-most (max) key's value
+	tree.RangeIterator(1, 5) // get an iterator over the keys in [1, 5)
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"github.com/uncle-gua/gods/trees/diskbtree"
+	"github.com/uncle-gua/gods/utils"
+	"os"
+	"path/filepath"
+)
+
+// DiskBTreeExample to demonstrate basic usage of DiskBTree
+func main() {
+	path := filepath.Join(os.TempDir(), "diskbtree-example")
+
+	// keys are of type int and values of type string, stored in 4 KiB pages with at most 256 nodes in memory
+	tree, err := diskbtree.Open(path, 3, utils.IntComparator, diskbtree.IntCodec, diskbtree.StringCodec, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	tree.Put(1, "x") // 1->x
+	tree.Put(2, "b") // 1->x, 2->b (in order)
+	tree.Put(1, "a") // 1->a, 2->b (in order, replacement)
+	tree.Put(3, "c") // 1->a, 2->b, 3->c (in order)
+	tree.Put(4, "d") // 1->a, 2->b, 3->c, 4->d (in order)
+
+	if err := tree.Commit(); err != nil { // writes the modified nodes and syncs the file
+		panic(err)
+	}
+
+	tree.Remove(2) // 1->a, 3->c, 4->d (in order), lost on a crash until committed
+
+	fmt.Println(tree)
+	// DiskBTree
+	//     1
+	// 3
+	//     4
+
+	if err := tree.Close(); err != nil { // commits and closes the file
+		panic(err)
+	}
+
+	tree, err = diskbtree.Open(path, 3, utils.IntComparator, diskbtree.IntCodec, diskbtree.StringCodec, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	_ = tree.Values() // []interface {}{"a", "c", "d"} (in order)
+	_ = tree.Keys()   // []interface {}{1, 3, 4} (in order)
+
+	// Other:
+	tree.Height()       // gets the height of the tree
+	tree.LeftKey()      // get the left-most (min) key
+	tree.RightValue()   // get the right-most (max) key's value
+	tree.MaxEntrySize() // get the maximum length of an encoded key and value
+
+	if err := tree.Err(); err != nil { // get the first error reading or writing the file
+		panic(err)
+	}
+	if err := tree.Close(); err != nil {
+		panic(err)
+	}
+	if err := os.Remove(path); err != nil {
+		panic(err)
+	}
+}
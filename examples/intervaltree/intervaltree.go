@@ -0,0 +1,26 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "github.com/uncle-gua/gods/trees/intervaltree"
+
+// IntervalTreeExample to demonstrate basic usage of IntervalTree
+func main() {
+	tree := intervaltree.NewWithIntComparator() // empty (endpoints are of type int)
+	tree.Put(15, 20, "a")                       // [15, 20]->a
+	tree.Put(10, 30, "b")                       // [10, 30]->b, [15, 20]->a (in order)
+	tree.Put(17, 19, "c")                       // [10, 30]->b, [15, 20]->a, [17, 19]->c (in order)
+	tree.Put(30, 40, "d")                       // [10, 30]->b, [15, 20]->a, [17, 19]->c, [30, 40]->d (in order)
+	_, _ = tree.Get(15, 20)                     // a, true
+	_, _ = tree.Get(15, 21)                     // nil, false
+	_ = tree.Stab(18)                           // [10, 30]->b, [15, 20]->a, [17, 19]->c
+	_ = tree.Overlap(25, 35)                    // [10, 30]->b, [30, 40]->d
+	_ = tree.Overlaps(41, 50)                   // false
+	_ = tree.Values()                           // []interface {}{"b", "a", "c", "d"} (in order)
+	tree.Remove(10, 30)                         // [15, 20]->a, [17, 19]->c, [30, 40]->d (in order)
+	tree.Clear()                                // empty
+	tree.Empty()                                // true
+	tree.Size()                                 // 0
+}
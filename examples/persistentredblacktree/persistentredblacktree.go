@@ -0,0 +1,27 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	prbt "github.com/uncle-gua/gods/trees/persistentredblacktree"
+)
+
+// PersistentRedBlackTreeExample to demonstrate basic usage of PersistentRedBlackTree
+func main() {
+	tree := prbt.NewWithIntComparator() // empty (keys are of type int)
+
+	tree = tree.Put(1, "x") // 1->x
+	tree = tree.Put(2, "b") // 1->x, 2->b (in order)
+	tree = tree.Put(1, "a") // 1->a, 2->b (in order, replacement)
+	tree = tree.Put(3, "c") // 1->a, 2->b, 3->c (in order)
+
+	snapshot := tree // O(1) snapshot, can be read concurrently
+
+	tree = tree.Remove(2) // 1->a, 3->c (in order)
+
+	fmt.Println(tree.Keys())     // [1 3]
+	fmt.Println(snapshot.Keys()) // [1 2 3]
+}
@@ -0,0 +1,22 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "github.com/uncle-gua/gods/maps/persistenttreemap"
+
+// PersistentTreeMapExample to demonstrate basic usage of PersistentTreeMap
+func main() {
+	m0 := persistenttreemap.NewWithIntComparator() // empty (keys are of type int)
+	m1 := m0.Put(1, "x")                           // 1->x (m0 is still empty)
+	m2 := m1.Put(2, "b")                           // 1->x, 2->b (in order, m1 is still 1->x)
+	m3 := m2.Put(1, "a")                           // 1->a, 2->b (in order, m2 is still 1->x, 2->b)
+	_, _ = m3.Get(1)                               // a, true
+	_, _ = m2.Get(1)                               // x, true
+	_ = m3.Keys()                                  // []interface {}{1, 2} (in order)
+	m4 := m3.Remove(1)                             // 2->b (m3 is still 1->a, 2->b)
+	m5 := m4.Clear()                               // empty
+	m5.Empty()                                     // true
+	m4.Size()                                      // 1
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/uncle-gua/gods/lists/arraylist"
+	"github.com/uncle-gua/gods/maps/treemap"
+	"github.com/uncle-gua/gods/pipeline"
+	"github.com/uncle-gua/gods/utils"
+)
+
+// PipelineExample to demonstrate basic usage of lazy pipelines
+func main() {
+	list := arraylist.New()
+	for i := 1; i <= 1000; i++ {
+		list.Add(i)
+	}
+
+	// only the first six values are visited
+	it := list.Iterator()
+	squares := pipeline.FromIteratorWithIndex(&it).
+		Filter(func(index int, value interface{}) bool {
+			return value.(int)%2 == 0
+		}).
+		Map(func(index int, value interface{}) interface{} {
+			return value.(int) * value.(int)
+		}).
+		Take(3).
+		ToArrayList()
+	fmt.Println(squares.Values()) // [4 16 36]
+
+	m := treemap.NewWithStringComparator()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+
+	// invert the map, skipping the first element
+	mi := m.Iterator()
+	inverted := pipeline.FromIteratorWithKey(&mi).
+		Skip(1).
+		Map(func(key interface{}, value interface{}) (interface{}, interface{}) {
+			return value, key
+		}).
+		ToTreeMap(utils.IntComparator)
+	fmt.Println(inverted) // TreeMap map[2:b 3:c]
+
+	// pair values of two pipelines
+	names := pipeline.FromValues("x", "y", "z")
+	scores := pipeline.FromValues(10, 20)
+	fmt.Println(names.Zip(scores).ToTreeMap(utils.StringComparator)) // TreeMap map[x:10 y:20]
+}
@@ -24,6 +24,7 @@ var _ lists.List = (*List)(nil)
 type List struct {
 	elements []interface{}
 	size     int
+	modCount int // number of structural modifications, checked by iterators
 }
 
 const (
@@ -42,6 +43,7 @@ func New(values ...interface{}) *List {
 
 // Add appends a value at the end of the list
 func (list *List) Add(values ...interface{}) {
+	list.modCount++
 	list.growBy(len(values))
 	for _, value := range values {
 		list.elements[list.size] = value
@@ -67,6 +69,7 @@ func (list *List) Remove(index int) {
 		return
 	}
 
+	list.modCount++
 	list.elements[index] = nil                                    // cleanup reference
 	copy(list.elements[index:], list.elements[index+1:list.size]) // shift to the left by one (slow operation, need ways to optimize this)
 	list.size--
@@ -127,6 +130,7 @@ func (list *List) Size() int {
 
 // Clear removes all elements from the list.
 func (list *List) Clear() {
+	list.modCount++
 	list.size = 0
 	list.elements = []interface{}{}
 }
@@ -136,9 +140,43 @@ func (list *List) Sort(comparator utils.Comparator) {
 	if len(list.elements) < 2 {
 		return
 	}
+	list.modCount++
 	utils.Sort(list.elements[:list.size], comparator)
 }
 
+// SortStable sorts values (in-place) keeping the original order of equal values.
+func (list *List) SortStable(comparator utils.Comparator) {
+	if list.size < 2 {
+		return
+	}
+	list.modCount++
+	utils.SortStable(list.elements[:list.size], comparator)
+}
+
+// ParallelSort sorts values (in-place) keeping the original order of equal values,
+// sorting parts of large lists concurrently. Comparator must be safe for concurrent use.
+func (list *List) ParallelSort(comparator utils.Comparator) {
+	if list.size < 2 {
+		return
+	}
+	list.modCount++
+	utils.ParallelSort(list.elements[:list.size], comparator)
+}
+
+// TopK returns the first k values (or all values if there are less than k) in sorted order.
+// Does not affect the ordering of elements within the list.
+func (list *List) TopK(k int, comparator utils.Comparator) []interface{} {
+	values := list.Values()
+	utils.PartialSort(values, k, comparator)
+	if k < 0 {
+		k = 0
+	}
+	if k < len(values) {
+		values = values[:k]
+	}
+	return values
+}
+
 // Swap swaps the two values at the specified positions.
 func (list *List) Swap(i, j int) {
 	if list.withinRange(i) && list.withinRange(j) {
@@ -159,6 +197,7 @@ func (list *List) Insert(index int, values ...interface{}) {
 		return
 	}
 
+	list.modCount++
 	l := len(values)
 	list.growBy(l)
 	list.size += l
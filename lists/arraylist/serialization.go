@@ -23,6 +23,7 @@ func (list *List) ToJSON() ([]byte, error) {
 func (list *List) FromJSON(data []byte) error {
 	err := json.Unmarshal(data, &list.elements)
 	if err == nil {
+		list.modCount++
 		list.size = len(list.elements)
 	}
 	return err
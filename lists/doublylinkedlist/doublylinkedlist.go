@@ -13,18 +13,21 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/uncle-gua/gods/containers"
 	"github.com/uncle-gua/gods/lists"
 	"github.com/uncle-gua/gods/utils"
 )
 
 // Assert List implementation
 var _ lists.List = (*List)(nil)
+var _ containers.Validator = (*List)(nil)
 
 // List holds the elements, where each element points to the next and previous element
 type List struct {
-	first *element
-	last  *element
-	size  int
+	first    *element
+	last     *element
+	size     int
+	modCount int // number of structural modifications, checked by iterators
 }
 
 type element struct {
@@ -44,6 +47,7 @@ func New(values ...interface{}) *List {
 
 // Add appends a value (one or more) at the end of the list (same as Append())
 func (list *List) Add(values ...interface{}) {
+	list.modCount++
 	for _, value := range values {
 		newElement := &element{value: value, prev: list.last}
 		if list.size == 0 {
@@ -64,6 +68,7 @@ func (list *List) Append(values ...interface{}) {
 
 // Prepend prepends a values (or more)
 func (list *List) Prepend(values ...interface{}) {
+	list.modCount++
 	// in reverse to keep passed order i.e. ["c","d"] -> Prepend(["a","b"]) -> ["a","b","c",d"]
 	for v := len(values) - 1; v >= 0; v-- {
 		newElement := &element{value: values[v], next: list.first}
@@ -123,6 +128,13 @@ func (list *List) Remove(index int) {
 		}
 	}
 
+	list.unlink(element)
+}
+
+// unlink removes the element from the list in constant time.
+// The removed element keeps its links to its former neighbours.
+func (list *List) unlink(element *element) {
+	list.modCount++
 	if element == list.first {
 		list.first = element.next
 	}
@@ -135,9 +147,6 @@ func (list *List) Remove(index int) {
 	if element.next != nil {
 		element.next.prev = element.prev
 	}
-
-	element = nil
-
 	list.size--
 }
 
@@ -202,6 +211,7 @@ func (list *List) Size() int {
 
 // Clear removes all elements from the list.
 func (list *List) Clear() {
+	list.modCount++
 	list.size = 0
 	list.first = nil
 	list.last = nil
@@ -223,6 +233,53 @@ func (list *List) Sort(comparator utils.Comparator) {
 
 }
 
+// SortStable sorts values (in-place) keeping the original order of equal values.
+func (list *List) SortStable(comparator utils.Comparator) {
+
+	if list.size < 2 {
+		return
+	}
+
+	values := list.Values()
+	utils.SortStable(values, comparator)
+
+	list.Clear()
+
+	list.Add(values...)
+
+}
+
+// ParallelSort sorts values (in-place) keeping the original order of equal values,
+// sorting parts of large lists concurrently. Comparator must be safe for concurrent use.
+func (list *List) ParallelSort(comparator utils.Comparator) {
+
+	if list.size < 2 {
+		return
+	}
+
+	values := list.Values()
+	utils.ParallelSort(values, comparator)
+
+	list.Clear()
+
+	list.Add(values...)
+
+}
+
+// TopK returns the first k values (or all values if there are less than k) in sorted order.
+// Does not affect the ordering of elements within the list.
+func (list *List) TopK(k int, comparator utils.Comparator) []interface{} {
+	values := list.Values()
+	utils.PartialSort(values, k, comparator)
+	if k < 0 {
+		k = 0
+	}
+	if k < len(values) {
+		values = values[:k]
+	}
+	return values
+}
+
 // Swap swaps values of two elements at the given indices.
 func (list *List) Swap(i, j int) {
 	if list.withinRange(i) && list.withinRange(j) && i != j {
@@ -252,6 +309,7 @@ func (list *List) Insert(index int, values ...interface{}) {
 		return
 	}
 
+	list.modCount++
 	var beforeElement *element
 	var foundElement *element
 	// determine traversal direction, last to first or first to last
@@ -339,6 +397,32 @@ func (list *List) String() string {
 	return str
 }
 
+// Validate checks that the number of elements linked from the first one matches the size, that each element links
+// back to its predecessor and that the last element is the one at the end of the links. Returns an error wrapping
+// containers.ErrInvalid, which describes the first violated rule and the index where it was violated, or nil if the
+// list is valid.
+func (list *List) Validate() error {
+	index := 0
+	var last *element
+	for element := list.first; element != nil; element = element.next {
+		if index == list.size {
+			return fmt.Errorf("%w: element %v at index %d is beyond the size %d", containers.ErrInvalid, element.value, index, list.size)
+		}
+		if element.prev != last {
+			return fmt.Errorf("%w: element %v at index %d does not link back to its predecessor", containers.ErrInvalid, element.value, index)
+		}
+		last = element
+		index++
+	}
+	if index != list.size {
+		return fmt.Errorf("%w: list has %d elements instead of the size %d", containers.ErrInvalid, index, list.size)
+	}
+	if list.last != last {
+		return fmt.Errorf("%w: last element is not the one at index %d", containers.ErrInvalid, index-1)
+	}
+	return nil
+}
+
 // Check that the index is within bounds of the list
 func (list *List) withinRange(index int) bool {
 	return index >= 0 && index < list.size
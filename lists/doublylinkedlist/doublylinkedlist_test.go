@@ -7,9 +7,13 @@ package doublylinkedlist
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
 
+	"errors"
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/maps/hashmap"
 	"github.com/uncle-gua/gods/utils"
 )
 
@@ -141,6 +145,62 @@ func TestListSort(t *testing.T) {
 	}
 }
 
+func TestListSortStable(t *testing.T) {
+	byFirstLetter := utils.By(func(s interface{}) interface{} { return s.(string)[:1] }, utils.StringComparator)
+	list := New()
+	list.SortStable(byFirstLetter)
+	list.Add("b2", "a1", "b1", "c1", "a2", "b3", "a3")
+	list.SortStable(byFirstLetter)
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[a1 a2 a3 b2 b1 b3 c1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListParallelSort(t *testing.T) {
+	byFirstLetter := utils.By(func(s interface{}) interface{} { return s.(string)[:1] }, utils.StringComparator)
+	list := New()
+	list.ParallelSort(byFirstLetter)
+	list.Add("b2", "a1", "b1", "c1", "a2", "b3", "a3")
+	list.ParallelSort(byFirstLetter)
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[a1 a2 a3 b2 b1 b3 c1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	list.Clear()
+	for i := 10000; i > 0; i-- {
+		list.Add(i)
+	}
+	list.ParallelSort(utils.IntComparator)
+	for i := 0; i < list.Size(); i++ {
+		if actualValue, _ := list.Get(i); actualValue != i+1 {
+			t.Fatalf("Got %v expected %v", actualValue, i+1)
+		}
+	}
+}
+
+func TestListTopK(t *testing.T) {
+	list := New()
+	if actualValue := list.TopK(3, utils.IntComparator); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+	list.Add(5, 1, 4, 2, 3)
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.TopK(3, utils.IntComparator)), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.TopK(2, utils.Reverse(utils.IntComparator))), "[5 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.TopK(10, utils.IntComparator)), "[1 2 3 4 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := list.TopK(0, utils.IntComparator); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[5 1 4 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestListClear(t *testing.T) {
 	list := New()
 	list.Add("e", "f", "g", "a", "b", "c", "d")
@@ -369,6 +429,112 @@ func TestListFind(t *testing.T) {
 		t.Errorf("Got %v at %v expected %v at %v", foundValue, foundIndex, nil, nil)
 	}
 }
+
+func TestListFold(t *testing.T) {
+	c := New(1, 2, 3, 4)
+	if actualValue, expectedValue := c.Fold(10, func(accumulator interface{}, index int, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}), 20; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := c.Reduce(func(accumulator interface{}, index int, value interface{}) interface{} {
+		return accumulator.(int) * value.(int)
+	}), 24; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	empty := New()
+	if actualValue, expectedValue := empty.Fold(10, func(accumulator interface{}, index int, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := empty.Reduce(func(accumulator interface{}, index int, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestListCount(t *testing.T) {
+	c := New(1, 2, 3, 4)
+	if actualValue, expectedValue := c.Count(func(index int, value interface{}) bool {
+		return value.(int)%2 == 0
+	}), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListGroupBy(t *testing.T) {
+	c := New(1, 2, 3, 4)
+	groups := hashmap.New()
+	c.GroupBy(func(index int, value interface{}) interface{} {
+		return value.(int)%2 == 0
+	}, groups)
+	if actualValue, expectedValue := groups.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tests := [][]interface{}{
+		{true, "[2 4]"},
+		{false, "[1 3]"},
+	}
+	for _, test := range tests {
+		group, _ := groups.Get(test[0])
+		if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(group.(*List), utils.IntComparator)), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestListPartition(t *testing.T) {
+	c := New(1, 2, 3, 4)
+	selected, rest := c.Partition(func(index int, value interface{}) bool {
+		return value.(int)%2 == 0
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(selected, utils.IntComparator)), "[2 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(rest, utils.IntComparator)), "[1 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListMinByMaxBy(t *testing.T) {
+	c := New(1, 2, 3, 4)
+	if _, actualValue := c.MinBy(utils.IntComparator); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if _, actualValue := c.MaxBy(utils.IntComparator); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	empty := New()
+	if index, value := empty.MinBy(utils.IntComparator); index != -1 || value != nil {
+		t.Errorf("Got %v expected %v", value, nil)
+	}
+	if index, value := empty.MaxBy(utils.IntComparator); index != -1 || value != nil {
+		t.Errorf("Got %v expected %v", value, nil)
+	}
+}
+
+func TestListSum(t *testing.T) {
+	c := New(1, 2, 3, 4)
+	if actualValue, expectedValue := c.SumInt(func(index int, value interface{}) int {
+		return value.(int)
+	}), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := c.SumFloat64(func(index int, value interface{}) float64 {
+		return float64(value.(int)) / 2
+	}), 5.0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListDistinct(t *testing.T) {
+	list := New(1, 2, 1, 3, 2)
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.Distinct().Values()), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
 func TestListChaining(t *testing.T) {
 	list := New()
 	list.Add("a", "b", "c")
@@ -681,6 +847,177 @@ func TestListString(t *testing.T) {
 	}
 }
 
+func TestListIteratorConcurrentModification(t *testing.T) {
+	list := New("a", "b", "c")
+
+	// modifications before the first element are allowed
+	it := list.Iterator()
+	list.Add("d")
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, list.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// modifications during iteration panic
+	func() {
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		it := list.Iterator()
+		it.Next()
+		list.Remove(0)
+		it.Next()
+	}()
+
+	// checked iterator stops and reports the error
+	checked := list.CheckedIterator()
+	checked.Next()
+	list.Add("e")
+	if checked.Next() {
+		t.Errorf("Should not iterate after modification")
+	}
+	if actualValue, expectedValue := checked.Err(), containers.ErrConcurrentModification; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checked.Begin()
+	if actualValue := checked.Err(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	count = 0
+	for checked.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, list.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListIteratorClone(t *testing.T) {
+	list := New("a", "b", "c")
+
+	it := list.Iterator()
+	it.Next()
+	clone := it.Clone()
+	first := it.Value()
+	it.Next()
+	if actualValue, expectedValue := clone.Value(), first; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !clone.Next() || clone.Value() != it.Value() {
+		t.Errorf("Got %v expected %v", clone.Value(), it.Value())
+	}
+	clone.Next()
+	if clone.Next() {
+		t.Errorf("Should not go past the last element")
+	}
+	if !it.Next() {
+		t.Errorf("Should move independently of the clone")
+	}
+}
+
+func TestListIteratorRemove(t *testing.T) {
+	list := New("a", "b", "c", "d", "e")
+	it := list.Iterator()
+	if it.Remove() {
+		t.Errorf("Should not remove before the first element")
+	}
+	for it.Next() {
+		if value := it.Value(); value == "a" || value == "c" || value == "e" {
+			if !it.Remove() {
+				t.Errorf("Should remove %v", value)
+			}
+			if it.Remove() {
+				t.Errorf("Should not remove %v twice", value)
+			}
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[b d]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if it.Remove() {
+		t.Errorf("Should not remove past the last element")
+	}
+
+}
+
+func TestListIteratorRemoveNeighbours(t *testing.T) {
+	// the iterator is left between the neighbours of the removed element
+	list := New("a", "b", "c")
+	it := list.Iterator()
+	it.Next()
+	it.Next()
+	it.Remove()
+	tests := [][]interface{}{
+		{it.Prev, 0, "a"},
+		{it.Next, 1, "c"},
+	}
+	for _, test := range tests {
+		if !test[0].(func() bool)() {
+			t.Errorf("Should move to %v", test[2])
+		}
+		if actualValue, expectedValue := it.Index(), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := it.Value(), test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	it.Remove()
+	if it.Next() {
+		t.Errorf("Should not move past the last element")
+	}
+	if !it.Prev() || it.Value() != "a" {
+		t.Errorf("Got %v expected %v", it.Value(), "a")
+	}
+	it.Remove()
+	if it.Prev() || it.Next() {
+		t.Errorf("Should not move in an empty list")
+	}
+	if actualValue, expectedValue := list.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListSeq(t *testing.T) {
+	list := New()
+	list.Add("a", "b", "c")
+
+	pairs := []string{}
+	for index, value := range list.AllSeq() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", index, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "0:a 1:b 2:c"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(list.ValuesSeq())), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	pairs = []string{}
+	for index, value := range list.Backward() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", index, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "2:c 1:b 0:a"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	count := 0
+	for index := range list.AllSeq() {
+		if index == 1 {
+			break
+		}
+		count++
+	}
+	if actualValue, expectedValue := count, 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func benchmarkGet(b *testing.B, list *List, size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -833,3 +1170,31 @@ func BenchmarkDoublyLinkedListRemove100000(b *testing.B) {
 	b.StartTimer()
 	benchmarkRemove(b, list, size)
 }
+
+func TestListValidate(t *testing.T) {
+	tests := [][]interface{}{
+		{func(list *List) {}, ""},
+		{func(list *List) { list.size = 2 }, "element c at index 2 is beyond the size 2"},
+		{func(list *List) { list.size = 4 }, "list has 3 elements instead of the size 4"},
+		{func(list *List) { list.first.prev = list.last }, "element a at index 0 does not link back to its predecessor"},
+		{func(list *List) { list.last.prev = list.first }, "element c at index 2 does not link back to its predecessor"},
+		{func(list *List) { list.last = list.first }, "last element is not the one at index 2"},
+	}
+	for _, test := range tests {
+		list := New("a", "b", "c")
+		test[0].(func(*List))(list)
+		err := list.Validate()
+		if test[1] == "" {
+			if err != nil {
+				t.Errorf("Got %v expected %v", err, nil)
+			}
+			continue
+		}
+		if !errors.Is(err, containers.ErrInvalid) {
+			t.Errorf("Got %v expected %v", err, containers.ErrInvalid)
+		}
+		if err != nil && !strings.HasSuffix(err.Error(), test[1].(string)) {
+			t.Errorf("Got %v expected %v", err, test[1])
+		}
+	}
+}
@@ -4,7 +4,10 @@
 
 package doublylinkedlist
 
-import "github.com/uncle-gua/gods/containers"
+import (
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/utils"
+)
 
 // Assert Enumerable implementation
 var _ containers.EnumerableWithIndex = (*List)(nil)
@@ -76,3 +79,131 @@ func (list *List) Find(f func(index int, value interface{}) bool) (index int, va
 	}
 	return -1, nil
 }
+
+// Fold passes each element of the container to the given function together with the accumulated value,
+// starting with the initial value, and returns the last value returned by the function (or the initial value).
+func (list *List) Fold(initial interface{}, f func(accumulator interface{}, index int, value interface{}) interface{}) interface{} {
+	accumulator := initial
+	iterator := list.Iterator()
+	for iterator.Next() {
+		accumulator = f(accumulator, iterator.Index(), iterator.Value())
+	}
+	return accumulator
+}
+
+// Reduce works like Fold, starting with the first element's value as the accumulated value,
+// and returns nil if the container is empty.
+func (list *List) Reduce(f func(accumulator interface{}, index int, value interface{}) interface{}) interface{} {
+	iterator := list.Iterator()
+	if !iterator.Next() {
+		return nil
+	}
+	accumulator := iterator.Value()
+	for iterator.Next() {
+		accumulator = f(accumulator, iterator.Index(), iterator.Value())
+	}
+	return accumulator
+}
+
+// Count returns the number of elements for which the given function returns a true value.
+func (list *List) Count(f func(index int, value interface{}) bool) int {
+	count := 0
+	iterator := list.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			count++
+		}
+	}
+	return count
+}
+
+// GroupBy puts each element into a group stored in the given map under the key returned by the given function.
+// Groups are new lists of the elements in iteration order, the map should not hold any other values.
+func (list *List) GroupBy(f func(index int, value interface{}) interface{}, groups containers.Groups) {
+	iterator := list.Iterator()
+	for iterator.Next() {
+		key := f(iterator.Index(), iterator.Value())
+		group, found := groups.Get(key)
+		if !found {
+			group = &List{}
+			groups.Put(key, group)
+		}
+		group.(*List).Add(iterator.Value())
+	}
+}
+
+// Partition returns a new list containing all elements for which the given function returns a true value
+// and a new list containing the rest of the elements.
+func (list *List) Partition(f func(index int, value interface{}) bool) (containers.Container, containers.Container) {
+	selected, rest := &List{}, &List{}
+	iterator := list.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			selected.Add(iterator.Value())
+		} else {
+			rest.Add(iterator.Value())
+		}
+	}
+	return selected, rest
+}
+
+// MinBy returns the first smallest (index,value) according to the given comparator of values
+// or -1,nil if the container is empty.
+func (list *List) MinBy(comparator utils.Comparator) (int, interface{}) {
+	index, value := -1, interface{}(nil)
+	iterator := list.Iterator()
+	for iterator.Next() {
+		if index == -1 || comparator(iterator.Value(), value) < 0 {
+			index, value = iterator.Index(), iterator.Value()
+		}
+	}
+	return index, value
+}
+
+// MaxBy returns the first largest (index,value) according to the given comparator of values
+// or -1,nil if the container is empty.
+func (list *List) MaxBy(comparator utils.Comparator) (int, interface{}) {
+	index, value := -1, interface{}(nil)
+	iterator := list.Iterator()
+	for iterator.Next() {
+		if index == -1 || comparator(iterator.Value(), value) > 0 {
+			index, value = iterator.Index(), iterator.Value()
+		}
+	}
+	return index, value
+}
+
+// SumInt returns the sum of the numbers returned by the given function for each element.
+func (list *List) SumInt(f func(index int, value interface{}) int) int {
+	var sum int
+	iterator := list.Iterator()
+	for iterator.Next() {
+		sum += f(iterator.Index(), iterator.Value())
+	}
+	return sum
+}
+
+// SumFloat64 returns the sum of the numbers returned by the given function for each element.
+func (list *List) SumFloat64(f func(index int, value interface{}) float64) float64 {
+	var sum float64
+	iterator := list.Iterator()
+	for iterator.Next() {
+		sum += f(iterator.Index(), iterator.Value())
+	}
+	return sum
+}
+
+// Distinct returns a new list containing the elements without repeated values, keeping the first occurrence of each value.
+// Values must be comparable with == (i.e. usable as map keys), otherwise the method panics.
+func (list *List) Distinct() containers.Container {
+	newList := &List{}
+	seen := make(map[interface{}]struct{})
+	iterator := list.Iterator()
+	for iterator.Next() {
+		if _, found := seen[iterator.Value()]; !found {
+			seen[iterator.Value()] = struct{}{}
+			newList.Add(iterator.Value())
+		}
+	}
+	return newList
+}
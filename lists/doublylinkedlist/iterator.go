@@ -4,21 +4,38 @@
 
 package doublylinkedlist
 
-import "github.com/uncle-gua/gods/containers"
+import (
+	"iter"
+
+	"github.com/uncle-gua/gods/containers"
+)
 
 // Assert Iterator implementation
 var _ containers.ReverseIteratorWithIndex = (*Iterator)(nil)
 
 // Iterator holding the iterator's state
 type Iterator struct {
-	list    *List
-	index   int
-	element *element
+	list     *List
+	index    int
+	element  *element
+	removed  bool
+	failFast containers.FailFast
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
+// The iterator fails fast: moving it after the list was structurally modified other than through the iterator
+// panics with containers.ErrConcurrentModification. Modifications made while the iterator is in its initial
+// (one-before-first) state, or before it is reset by Begin() or End(), are allowed.
 func (list *List) Iterator() Iterator {
-	return Iterator{list: list, index: -1, element: nil}
+	return Iterator{list: list, index: -1, element: nil, failFast: containers.NewFailFast(list.modCount)}
+}
+
+// CheckedIterator returns a stateful iterator like Iterator(), except that instead of panicking when the list was
+// modified during iteration, it stops and reports containers.ErrConcurrentModification by Err().
+func (list *List) CheckedIterator() Iterator {
+	iterator := list.Iterator()
+	iterator.failFast.Check()
+	return iterator
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -26,6 +43,10 @@ func (list *List) Iterator() Iterator {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator) Next() bool {
+	if !iterator.failFast.Move(iterator.list.modCount, iterator.index == -1) {
+		return false
+	}
+	iterator.removed = false
 	if iterator.index < iterator.list.size {
 		iterator.index++
 	}
@@ -45,6 +66,13 @@ func (iterator *Iterator) Next() bool {
 // If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator) Prev() bool {
+	if iterator.modified() {
+		return false
+	}
+	if iterator.removed {
+		iterator.removed = false
+		return iterator.list.withinRange(iterator.index)
+	}
 	if iterator.index >= 0 {
 		iterator.index--
 	}
@@ -72,11 +100,20 @@ func (iterator *Iterator) Index() int {
 	return iterator.index
 }
 
+// Clone returns an independent copy of the iterator at the same position, e.g. for lookahead or backtracking.
+// Moving either of the iterators does not move the other one.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Clone() Iterator {
+	return *iterator
+}
+
 // Begin resets the iterator to its initial state (one-before-first)
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator) Begin() {
 	iterator.index = -1
 	iterator.element = nil
+	iterator.removed = false
+	iterator.sync()
 }
 
 // End moves the iterator past the last element (one-past-the-end).
@@ -84,6 +121,24 @@ func (iterator *Iterator) Begin() {
 func (iterator *Iterator) End() {
 	iterator.index = iterator.list.size
 	iterator.element = iterator.list.last
+	iterator.removed = false
+	iterator.sync()
+}
+
+// Remove removes the current element from the list in constant time.
+// Afterwards the iterator is between the neighbours of the removed element, i.e. Next() moves to the element that
+// followed it and Prev() to the element that preceded it, Index() and Value() are undefined until then.
+// Returns false and does nothing if the iterator is not at an element.
+func (iterator *Iterator) Remove() bool {
+	if iterator.removed || iterator.index < 0 || iterator.modified() || !iterator.list.withinRange(iterator.index) {
+		return false
+	}
+	iterator.list.unlink(iterator.element)
+	iterator.element = iterator.element.prev
+	iterator.index--
+	iterator.removed = true
+	iterator.failFast.Sync(iterator.list.modCount)
+	return true
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -129,3 +184,59 @@ func (iterator *Iterator) PrevTo(f func(index int, value interface{}) bool) bool
 	}
 	return false
 }
+
+// Err returns containers.ErrConcurrentModification if the checked iterator stopped because the list was modified
+// during iteration, otherwise nil.
+func (iterator *Iterator) Err() error {
+	return iterator.failFast.Err()
+}
+
+// sync accepts the current state of the list, i.e. after the iterator was reset.
+func (iterator *Iterator) sync() {
+	iterator.failFast.Sync(iterator.list.modCount)
+}
+
+// modified returns true if the list was modified since the iterator was synchronised with it.
+// Panics with containers.ErrConcurrentModification, unless the iterator is checked.
+func (iterator *Iterator) modified() bool {
+	return iterator.failFast.Modified(iterator.list.modCount)
+}
+
+// AllSeq returns an iterator over index-value pairs of the list in iteration order, for use with range-over-func.
+// It is not named All, which is the predicate of Enumerable.
+func (list *List) AllSeq() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		iterator := list.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Index(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over values of the list in iteration order, for use with range-over-func.
+// It is not named Values, which returns the values as a slice.
+func (list *List) ValuesSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		iterator := list.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over index-value pairs of the list in reverse iteration order, for use with range-over-func.
+func (list *List) Backward() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		iterator := list.Iterator()
+		iterator.End()
+		for iterator.Prev() {
+			if !yield(iterator.Index(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
@@ -21,6 +21,9 @@ type List interface {
 	Add(values ...interface{})
 	Contains(values ...interface{}) bool
 	Sort(comparator utils.Comparator)
+	SortStable(comparator utils.Comparator)
+	ParallelSort(comparator utils.Comparator)
+	TopK(k int, comparator utils.Comparator) []interface{}
 	Swap(index1, index2 int)
 	Insert(index int, values ...interface{})
 	Set(index int, value interface{})
@@ -4,21 +4,37 @@
 
 package singlylinkedlist
 
-import "github.com/uncle-gua/gods/containers"
+import (
+	"iter"
+
+	"github.com/uncle-gua/gods/containers"
+)
 
 // Assert Iterator implementation
 var _ containers.IteratorWithIndex = (*Iterator)(nil)
 
 // Iterator holding the iterator's state
 type Iterator struct {
-	list    *List
-	index   int
-	element *element
+	list     *List
+	index    int
+	element  *element
+	failFast containers.FailFast
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
+// The iterator fails fast: moving it after the list was structurally modified other than through the iterator
+// panics with containers.ErrConcurrentModification. Modifications made while the iterator is in its initial
+// (one-before-first) state, or before it is reset by Begin(), are allowed.
 func (list *List) Iterator() Iterator {
-	return Iterator{list: list, index: -1, element: nil}
+	return Iterator{list: list, index: -1, element: nil, failFast: containers.NewFailFast(list.modCount)}
+}
+
+// CheckedIterator returns a stateful iterator like Iterator(), except that instead of panicking when the list was
+// modified during iteration, it stops and reports containers.ErrConcurrentModification by Err().
+func (list *List) CheckedIterator() Iterator {
+	iterator := list.Iterator()
+	iterator.failFast.Check()
+	return iterator
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -26,6 +42,9 @@ func (list *List) Iterator() Iterator {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator) Next() bool {
+	if !iterator.failFast.Move(iterator.list.modCount, iterator.index == -1) {
+		return false
+	}
 	if iterator.index < iterator.list.size {
 		iterator.index++
 	}
@@ -53,11 +72,19 @@ func (iterator *Iterator) Index() int {
 	return iterator.index
 }
 
+// Clone returns an independent copy of the iterator at the same position, e.g. for lookahead or backtracking.
+// Moving either of the iterators does not move the other one.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Clone() Iterator {
+	return *iterator
+}
+
 // Begin resets the iterator to its initial state (one-before-first)
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator) Begin() {
 	iterator.index = -1
 	iterator.element = nil
+	iterator.sync()
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -81,3 +108,40 @@ func (iterator *Iterator) NextTo(f func(index int, value interface{}) bool) bool
 	}
 	return false
 }
+
+// Err returns containers.ErrConcurrentModification if the checked iterator stopped because the list was modified
+// during iteration, otherwise nil.
+func (iterator *Iterator) Err() error {
+	return iterator.failFast.Err()
+}
+
+// sync accepts the current state of the list, i.e. after the iterator was reset.
+func (iterator *Iterator) sync() {
+	iterator.failFast.Sync(iterator.list.modCount)
+}
+
+// AllSeq returns an iterator over index-value pairs of the list in iteration order, for use with range-over-func.
+// It is not named All, which is the predicate of Enumerable.
+func (list *List) AllSeq() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		iterator := list.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Index(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over values of the list in iteration order, for use with range-over-func.
+// It is not named Values, which returns the values as a slice.
+func (list *List) ValuesSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		iterator := list.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Value()) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashbidimap
+
+import (
+	"iter"
+
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/maps/hashmap"
+)
+
+// Assert Iterator implementation
+var _ containers.IteratorWithKey = (*Iterator)(nil)
+
+// Iterator holding the iterator's state
+type Iterator struct {
+	iterator hashmap.Iterator
+}
+
+// Iterator returns a stateful iterator whose elements are key/value pairs.
+// Keys are iterated in random order, the keys are taken from the map when the iterator is created or reset,
+// which copies all keys in O(n) time and space. The enumerable methods (Each, Any, Find, ...) and the range-over-func
+// sequences range over the map directly instead.
+// The iterator fails fast: moving it after the map was structurally modified other than through the iterator
+// panics with containers.ErrConcurrentModification. Modifications made while the iterator is in its initial
+// (one-before-first) state, or before it is reset by Begin(), are allowed.
+func (m *Map) Iterator() Iterator {
+	return Iterator{iterator: m.forwardMap.Iterator()}
+}
+
+// CheckedIterator returns a stateful iterator like Iterator(), except that instead of panicking when the map was
+// modified during iteration, it stops and reports containers.ErrConcurrentModification by Err().
+func (m *Map) CheckedIterator() Iterator {
+	return Iterator{iterator: m.forwardMap.CheckedIterator()}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator) Next() bool {
+	return iterator.iterator.Next()
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Value() interface{} {
+	return iterator.iterator.Value()
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Key() interface{} {
+	return iterator.iterator.Key()
+}
+
+// Clone returns an independent copy of the iterator at the same position, e.g. for lookahead or backtracking.
+// Moving either of the iterators does not move the other one.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Clone() Iterator {
+	return *iterator
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator) Begin() {
+	iterator.iterator.Begin()
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) First() bool {
+	return iterator.iterator.First()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If NextTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) NextTo(f func(key interface{}, value interface{}) bool) bool {
+	return iterator.iterator.NextTo(f)
+}
+
+// Err returns containers.ErrConcurrentModification if the checked iterator stopped because the map was modified
+// during iteration, otherwise nil.
+func (iterator *Iterator) Err() error {
+	return iterator.iterator.Err()
+}
+
+// AllSeq returns an iterator over key-value pairs of the map in random order, for use with range-over-func.
+// It is not named All, which is the predicate of Enumerable.
+func (m *Map) AllSeq() iter.Seq2[interface{}, interface{}] {
+	return m.forwardMap.AllSeq()
+}
+
+// KeysSeq returns an iterator over keys of the map in random order, for use with range-over-func.
+// It is not named Keys, which returns the keys as a slice.
+func (m *Map) KeysSeq() iter.Seq[interface{}] {
+	return m.forwardMap.KeysSeq()
+}
+
+// ValuesSeq returns an iterator over values of the map in random order, for use with range-over-func.
+// It is not named Values, which returns the values as a slice.
+func (m *Map) ValuesSeq() iter.Seq[interface{}] {
+	return m.inverseMap.KeysSeq()
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashmap
+
+import (
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/lists/arraylist"
+	"github.com/uncle-gua/gods/utils"
+)
+
+// Assert Enumerable implementation
+var _ containers.EnumerableWithKey = (*Map)(nil)
+
+// Each calls the given function once for each element, passing that element's key and value.
+func (m *Map) Each(f func(key interface{}, value interface{})) {
+	for key, value := range m.m {
+		f(key, value)
+	}
+}
+
+// Map invokes the given function once for each element and returns a container
+// containing the values returned by the given function as key/value pairs.
+func (m *Map) Map(f func(key1 interface{}, value1 interface{}) (interface{}, interface{})) *Map {
+	newMap := New()
+	for key, value := range m.m {
+		key2, value2 := f(key, value)
+		newMap.Put(key2, value2)
+	}
+	return newMap
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+func (m *Map) Select(f func(key interface{}, value interface{}) bool) *Map {
+	newMap := New()
+	for key, value := range m.m {
+		if f(key, value) {
+			newMap.Put(key, value)
+		}
+	}
+	return newMap
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+func (m *Map) Any(f func(key interface{}, value interface{}) bool) bool {
+	for key, value := range m.m {
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+func (m *Map) All(f func(key interface{}, value interface{}) bool) bool {
+	for key, value := range m.m {
+		if !f(key, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (key,value) for which the function is true or nil,nil otherwise if no element
+// matches the criteria.
+func (m *Map) Find(f func(key interface{}, value interface{}) bool) (interface{}, interface{}) {
+	for key, value := range m.m {
+		if f(key, value) {
+			return key, value
+		}
+	}
+	return nil, nil
+}
+
+// Fold passes each element of the container to the given function together with the accumulated value,
+// starting with the initial value, and returns the last value returned by the function (or the initial value).
+func (m *Map) Fold(initial interface{}, f func(accumulator interface{}, key interface{}, value interface{}) interface{}) interface{} {
+	accumulator := initial
+	for key, value := range m.m {
+		accumulator = f(accumulator, key, value)
+	}
+	return accumulator
+}
+
+// Reduce works like Fold, starting with the first element's value as the accumulated value,
+// and returns nil if the container is empty.
+func (m *Map) Reduce(f func(accumulator interface{}, key interface{}, value interface{}) interface{}) interface{} {
+	var accumulator interface{}
+	first := true
+	for key, value := range m.m {
+		if first {
+			accumulator, first = value, false
+			continue
+		}
+		accumulator = f(accumulator, key, value)
+	}
+	return accumulator
+}
+
+// Count returns the number of elements for which the given function returns a true value.
+func (m *Map) Count(f func(key interface{}, value interface{}) bool) int {
+	count := 0
+	for key, value := range m.m {
+		if f(key, value) {
+			count++
+		}
+	}
+	return count
+}
+
+// GroupBy puts each element into a group stored in the given map under the key returned by the given function.
+// Groups are new array lists of the values in iteration order, the map should not hold any other values.
+func (m *Map) GroupBy(f func(key interface{}, value interface{}) interface{}, groups containers.Groups) {
+	for key, value := range m.m {
+		groupKey := f(key, value)
+		group, found := groups.Get(groupKey)
+		if !found {
+			group = arraylist.New()
+			groups.Put(groupKey, group)
+		}
+		group.(*arraylist.List).Add(value)
+	}
+}
+
+// Partition returns a new map containing all elements for which the given function returns a true value
+// and a new map containing the rest of the elements.
+func (m *Map) Partition(f func(key interface{}, value interface{}) bool) (containers.Container, containers.Container) {
+	selected, rest := New(), New()
+	for key, value := range m.m {
+		if f(key, value) {
+			selected.Put(key, value)
+		} else {
+			rest.Put(key, value)
+		}
+	}
+	return selected, rest
+}
+
+// MinBy returns the first smallest (key,value) according to the given comparator of values
+// or nil,nil if the container is empty.
+func (m *Map) MinBy(comparator utils.Comparator) (interface{}, interface{}) {
+	var foundKey, foundValue interface{}
+	found := false
+	for key, value := range m.m {
+		if !found || comparator(value, foundValue) < 0 {
+			foundKey, foundValue, found = key, value, true
+		}
+	}
+	return foundKey, foundValue
+}
+
+// MaxBy returns the first largest (key,value) according to the given comparator of values
+// or nil,nil if the container is empty.
+func (m *Map) MaxBy(comparator utils.Comparator) (interface{}, interface{}) {
+	var foundKey, foundValue interface{}
+	found := false
+	for key, value := range m.m {
+		if !found || comparator(value, foundValue) > 0 {
+			foundKey, foundValue, found = key, value, true
+		}
+	}
+	return foundKey, foundValue
+}
+
+// SumInt returns the sum of the numbers returned by the given function for each element.
+func (m *Map) SumInt(f func(key interface{}, value interface{}) int) int {
+	var sum int
+	for key, value := range m.m {
+		sum += f(key, value)
+	}
+	return sum
+}
+
+// SumFloat64 returns the sum of the numbers returned by the given function for each element.
+func (m *Map) SumFloat64(f func(key interface{}, value interface{}) float64) float64 {
+	var sum float64
+	for key, value := range m.m {
+		sum += f(key, value)
+	}
+	return sum
+}
+
+// Distinct returns a new map containing the key/value pairs without repeated values, keeping the first pair for each value.
+// Values must be comparable with == (i.e. usable as map keys), otherwise the method panics.
+func (m *Map) Distinct() containers.Container {
+	newMap := New()
+	seen := make(map[interface{}]struct{})
+	for key, value := range m.m {
+		if _, found := seen[value]; !found {
+			seen[value] = struct{}{}
+			newMap.Put(key, value)
+		}
+	}
+	return newMap
+}
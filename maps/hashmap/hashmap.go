@@ -21,7 +21,8 @@ var _ maps.Map = (*Map)(nil)
 
 // Map holds the elements in go's native map
 type Map struct {
-	m map[interface{}]interface{}
+	m        map[interface{}]interface{}
+	modCount int // number of structural modifications, checked by iterators
 }
 
 // New instantiates a hash map.
@@ -31,6 +32,9 @@ func New() *Map {
 
 // Put inserts element into the map.
 func (m *Map) Put(key interface{}, value interface{}) {
+	if _, found := m.m[key]; !found {
+		m.modCount++
+	}
 	m.m[key] = value
 }
 
@@ -43,7 +47,10 @@ func (m *Map) Get(key interface{}) (value interface{}, found bool) {
 
 // Remove removes the element from the map by key.
 func (m *Map) Remove(key interface{}) {
-	delete(m.m, key)
+	if _, found := m.m[key]; found {
+		m.modCount++
+		delete(m.m, key)
+	}
 }
 
 // Empty returns true if map does not contain any elements
@@ -80,6 +87,7 @@ func (m *Map) Values() []interface{} {
 
 // Clear removes all elements from the map.
 func (m *Map) Clear() {
+	m.modCount++
 	m.m = make(map[interface{}]interface{})
 }
 
@@ -7,8 +7,13 @@ package hashmap
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
+
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/lists/arraylist"
+	"github.com/uncle-gua/gods/utils"
 )
 
 func TestMapPut(t *testing.T) {
@@ -212,6 +217,526 @@ func benchmarkRemove(b *testing.B, m *Map, size int) {
 	}
 }
 
+func TestMapEach(t *testing.T) {
+	m := New()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	count := 0
+	m.Each(func(key interface{}, value interface{}) {
+		count++
+		switch key {
+		case "a":
+			if actualValue, expectedValue := value, 1; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case "b":
+			if actualValue, expectedValue := value, 2; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case "c":
+			if actualValue, expectedValue := value, 3; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+	})
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapMap(t *testing.T) {
+	m := New()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	mappedMap := m.Map(func(key1 interface{}, value1 interface{}) (key2 interface{}, value2 interface{}) {
+		return key1, value1.(int) * value1.(int)
+	})
+	if actualValue, _ := mappedMap.Get("c"); actualValue != 9 {
+		t.Errorf("Got %v expected %v", actualValue, "mapped: c")
+	}
+	if actualValue, _ := mappedMap.Get("a"); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, "mapped: a")
+	}
+	if actualValue, _ := mappedMap.Get("b"); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, "mapped: b")
+	}
+	if mappedMap.Size() != 3 {
+		t.Errorf("Got %v expected %v", mappedMap.Size(), 3)
+	}
+}
+
+func TestMapSelect(t *testing.T) {
+	m := New()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	selectedMap := m.Select(func(key interface{}, value interface{}) bool {
+		return key.(string) >= "a" && key.(string) <= "b"
+	})
+	if actualValue, _ := selectedMap.Get("a"); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, "value: a")
+	}
+	if actualValue, _ := selectedMap.Get("b"); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, "value: b")
+	}
+	if selectedMap.Size() != 2 {
+		t.Errorf("Got %v expected %v", selectedMap.Size(), 2)
+	}
+}
+
+func TestMapAny(t *testing.T) {
+	m := New()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	any := m.Any(func(key interface{}, value interface{}) bool {
+		return value.(int) == 3
+	})
+	if any != true {
+		t.Errorf("Got %v expected %v", any, true)
+	}
+	any = m.Any(func(key interface{}, value interface{}) bool {
+		return value.(int) == 4
+	})
+	if any != false {
+		t.Errorf("Got %v expected %v", any, false)
+	}
+}
+
+func TestMapEnumerableAllocations(t *testing.T) {
+	m := New()
+	for i := 0; i < 100; i++ {
+		m.Put(i, i)
+	}
+	count := 0
+	allocations := testing.AllocsPerRun(10, func() {
+		m.Each(func(key interface{}, value interface{}) { count++ })
+		m.Any(func(key interface{}, value interface{}) bool { return false })
+		m.Find(func(key interface{}, value interface{}) bool { return false })
+	})
+	if allocations != 0 {
+		t.Errorf("Got %v expected %v", allocations, 0)
+	}
+	if actualValue, expectedValue := count, 1100; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapAll(t *testing.T) {
+	m := New()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	all := m.All(func(key interface{}, value interface{}) bool {
+		return key.(string) >= "a" && key.(string) <= "c"
+	})
+	if all != true {
+		t.Errorf("Got %v expected %v", all, true)
+	}
+	all = m.All(func(key interface{}, value interface{}) bool {
+		return key.(string) >= "a" && key.(string) <= "b"
+	})
+	if all != false {
+		t.Errorf("Got %v expected %v", all, false)
+	}
+}
+
+func TestMapFind(t *testing.T) {
+	m := New()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	foundKey, foundValue := m.Find(func(key interface{}, value interface{}) bool {
+		return key.(string) == "c"
+	})
+	if foundKey != "c" || foundValue != 3 {
+		t.Errorf("Got %v -> %v expected %v -> %v", foundKey, foundValue, "c", 3)
+	}
+	foundKey, foundValue = m.Find(func(key interface{}, value interface{}) bool {
+		return key.(string) == "x"
+	})
+	if foundKey != nil || foundValue != nil {
+		t.Errorf("Got %v at %v expected %v at %v", foundValue, foundKey, nil, nil)
+	}
+}
+
+func TestMapFold(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	if actualValue, expectedValue := m.Fold(10, func(accumulator interface{}, key interface{}, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}), 20; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Reduce(func(accumulator interface{}, key interface{}, value interface{}) interface{} {
+		return accumulator.(int) * value.(int)
+	}), 24; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	empty := New()
+	if actualValue, expectedValue := empty.Fold(10, func(accumulator interface{}, key interface{}, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := empty.Reduce(func(accumulator interface{}, key interface{}, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestMapCount(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	if actualValue, expectedValue := m.Count(func(key interface{}, value interface{}) bool {
+		return value.(int)%2 == 0
+	}), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapGroupBy(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	groups := New()
+	m.GroupBy(func(key interface{}, value interface{}) interface{} {
+		return value.(int)%2 == 0
+	}, groups)
+	if actualValue, expectedValue := groups.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tests := [][]interface{}{
+		{true, "[2 4]"},
+		{false, "[1 3]"},
+	}
+	for _, test := range tests {
+		group, _ := groups.Get(test[0])
+		if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(group.(*arraylist.List), utils.IntComparator)), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestMapPartition(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	selected, rest := m.Partition(func(key interface{}, value interface{}) bool {
+		return value.(int)%2 == 0
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(selected, utils.IntComparator)), "[2 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(rest, utils.IntComparator)), "[1 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapMinByMaxBy(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	if key, value := m.MinBy(utils.IntComparator); key != "a" || value != 1 {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, "a", 1)
+	}
+	if key, value := m.MaxBy(utils.IntComparator); key != "d" || value != 4 {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, "d", 4)
+	}
+	empty := New()
+	if key, value := empty.MinBy(utils.IntComparator); key != nil || value != nil {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, nil, nil)
+	}
+	if key, value := empty.MaxBy(utils.IntComparator); key != nil || value != nil {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, nil, nil)
+	}
+}
+
+func TestMapSum(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	if actualValue, expectedValue := m.SumInt(func(key interface{}, value interface{}) int {
+		return value.(int)
+	}), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.SumFloat64(func(key interface{}, value interface{}) float64 {
+		return float64(value.(int)) / 2
+	}), 5.0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapDistinct(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 1)
+	distinct := m.Distinct()
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(distinct, utils.IntComparator)), "[1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapIteratorNextOnEmpty(t *testing.T) {
+	m := New()
+	it := m.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty map")
+	}
+}
+
+func TestMapIteratorNext(t *testing.T) {
+	m := New()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	it := m.Iterator()
+	keys := []interface{}{}
+	for it.Next() {
+		key, value := it.Key(), it.Value()
+		if actualValue, _ := m.Get(key); actualValue != value {
+			t.Errorf("Got %v expected %v", value, actualValue)
+		}
+		keys = append(keys, key)
+	}
+	utils.Sort(keys, utils.StringComparator)
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if it.Next() {
+		t.Errorf("Should not go past last element")
+	}
+}
+
+func TestMapIteratorBegin(t *testing.T) {
+	m := New()
+	it := m.Iterator()
+	it.Begin()
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+	it = m.Iterator()
+	for it.Next() {
+	}
+	it.Begin()
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapIteratorFirst(t *testing.T) {
+	m := New()
+	it := m.Iterator()
+	if actualValue, expectedValue := it.First(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+	it = m.Iterator()
+	it.Next()
+	it.Next()
+	key, value := it.Key(), it.Value()
+	it.Begin()
+	it.Next()
+	it.Next()
+	if actualKey, actualValue := it.Key(), it.Value(); actualKey != key || actualValue != value {
+		t.Errorf("Got %v,%v expected %v,%v", actualKey, actualValue, key, value)
+	}
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, _ := m.Get(it.Key()); actualValue != it.Value() {
+		t.Errorf("Got %v expected %v", it.Value(), actualValue)
+	}
+}
+
+func TestMapIteratorNextTo(t *testing.T) {
+	// Sample seek function, i.e. string starting with "b"
+	seek := func(index interface{}, value interface{}) bool {
+		return strings.HasSuffix(value.(string), "b")
+	}
+
+	// NextTo (empty)
+	{
+		m := New()
+		it := m.Iterator()
+		for it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty map")
+		}
+	}
+
+	// NextTo (not found)
+	{
+		m := New()
+		m.Put(0, "xx")
+		m.Put(1, "yy")
+		it := m.Iterator()
+		for it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty map")
+		}
+	}
+
+	// NextTo (found)
+	{
+		m := New()
+		m.Put(0, "aa")
+		m.Put(1, "bb")
+		m.Put(2, "cc")
+		it := m.Iterator()
+		it.Begin()
+		if !it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty map")
+		}
+		if index, value := it.Key(), it.Value(); index != 1 || value.(string) != "bb" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 1, "bb")
+		}
+		if it.NextTo(seek) {
+			t.Errorf("Should not find another element")
+		}
+	}
+}
+
+func TestMapIteratorConcurrentModification(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+
+	// modifications before the first element are allowed
+	it := m.Iterator()
+	m.Put("d", 4)
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, m.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// modifications during iteration panic
+	func() {
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		it := m.Iterator()
+		it.Next()
+		m.Remove("a")
+		it.Next()
+	}()
+
+	// checked iterator stops and reports the error
+	checked := m.CheckedIterator()
+	checked.Next()
+	m.Put("e", 5)
+	if checked.Next() {
+		t.Errorf("Should not iterate after modification")
+	}
+	if actualValue, expectedValue := checked.Err(), containers.ErrConcurrentModification; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checked.Begin()
+	if actualValue := checked.Err(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	count = 0
+	for checked.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, m.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapIteratorClone(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+
+	it := m.Iterator()
+	it.Next()
+	clone := it.Clone()
+	first := it.Key()
+	it.Next()
+	if actualValue, expectedValue := clone.Key(), first; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !clone.Next() || clone.Key() != it.Key() {
+		t.Errorf("Got %v expected %v", clone.Key(), it.Key())
+	}
+	clone.Next()
+	if clone.Next() {
+		t.Errorf("Should not go past the last element")
+	}
+	if !it.Next() {
+		t.Errorf("Should move independently of the clone")
+	}
+}
+
+func TestMapSeq(t *testing.T) {
+	m := New()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	pairs := []string{}
+	for key, value := range m.AllSeq() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", key, value))
+	}
+	slices.Sort(pairs)
+	if actualValue, expectedValue := strings.Join(pairs, " "), "a:1 b:2 c:3"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	keys := slices.Collect(m.KeysSeq())
+	utils.Sort(keys, utils.StringComparator)
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	values := slices.Collect(m.ValuesSeq())
+	utils.Sort(values, utils.IntComparator)
+	if actualValue, expectedValue := fmt.Sprintf("%v", values), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	count := 0
+	for range m.KeysSeq() {
+		count++
+		break
+	}
+	if actualValue, expectedValue := count, 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func BenchmarkHashMapGet100(b *testing.B) {
 	b.StopTimer()
 	size := 100
@@ -0,0 +1,162 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashmap
+
+import (
+	"iter"
+
+	"github.com/uncle-gua/gods/containers"
+)
+
+// Assert Iterator implementation
+var _ containers.IteratorWithKey = (*Iterator)(nil)
+
+// Iterator holding the iterator's state
+type Iterator struct {
+	m        *Map
+	keys     []interface{}
+	index    int
+	failFast containers.FailFast
+}
+
+// Iterator returns a stateful iterator whose elements are key/value pairs.
+// Keys are iterated in random order, the keys are taken from the map when the iterator is created or reset,
+// which copies all keys in O(n) time and space. The enumerable methods (Each, Any, Find, ...) and the range-over-func
+// sequences range over the map directly instead.
+// The iterator fails fast: moving it after the map was structurally modified other than through the iterator
+// panics with containers.ErrConcurrentModification. Modifications made while the iterator is in its initial
+// (one-before-first) state, or before it is reset by Begin(), are allowed.
+func (m *Map) Iterator() Iterator {
+	return Iterator{m: m, keys: m.Keys(), index: -1, failFast: containers.NewFailFast(m.modCount)}
+}
+
+// CheckedIterator returns a stateful iterator like Iterator(), except that instead of panicking when the map was
+// modified during iteration, it stops and reports containers.ErrConcurrentModification by Err().
+func (m *Map) CheckedIterator() Iterator {
+	iterator := m.Iterator()
+	iterator.failFast.Check()
+	return iterator
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator) Next() bool {
+	if iterator.index == -1 {
+		iterator.sync()
+	} else if iterator.modified() {
+		return false
+	}
+	if iterator.index < len(iterator.keys) {
+		iterator.index++
+	}
+	return iterator.index < len(iterator.keys)
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Value() interface{} {
+	return iterator.m.m[iterator.keys[iterator.index]]
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Key() interface{} {
+	return iterator.keys[iterator.index]
+}
+
+// Clone returns an independent copy of the iterator at the same position, e.g. for lookahead or backtracking.
+// Moving either of the iterators does not move the other one.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Clone() Iterator {
+	return *iterator
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator) Begin() {
+	iterator.index = -1
+	iterator.sync()
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If NextTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) NextTo(f func(key interface{}, value interface{}) bool) bool {
+	for iterator.Next() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns containers.ErrConcurrentModification if the checked iterator stopped because the map was modified
+// during iteration, otherwise nil.
+func (iterator *Iterator) Err() error {
+	return iterator.failFast.Err()
+}
+
+// sync accepts the current state of the map, i.e. after the iterator was reset,
+// taking the keys anew if the map has changed.
+func (iterator *Iterator) sync() {
+	if !iterator.failFast.InSync(iterator.m.modCount) {
+		iterator.keys = iterator.m.Keys()
+	}
+	iterator.failFast.Sync(iterator.m.modCount)
+}
+
+// modified returns true if the map was modified since the iterator was synchronised with it.
+// Panics with containers.ErrConcurrentModification, unless the iterator is checked.
+func (iterator *Iterator) modified() bool {
+	return iterator.failFast.Modified(iterator.m.modCount)
+}
+
+// AllSeq returns an iterator over key-value pairs of the map in random order, for use with range-over-func.
+// It is not named All, which is the predicate of Enumerable.
+func (m *Map) AllSeq() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		for key, value := range m.m {
+			if !yield(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns an iterator over keys of the map in random order, for use with range-over-func.
+// It is not named Keys, which returns the keys as a slice.
+func (m *Map) KeysSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for key := range m.m {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over values of the map in random order, for use with range-over-func.
+// It is not named Values, which returns the values as a slice.
+func (m *Map) ValuesSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for _, value := range m.m {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
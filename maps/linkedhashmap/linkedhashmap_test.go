@@ -7,6 +7,11 @@ package linkedhashmap
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/lists/arraylist"
+	"github.com/uncle-gua/gods/maps/hashmap"
+	"github.com/uncle-gua/gods/utils"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -267,6 +272,140 @@ func TestMapFind(t *testing.T) {
 	}
 }
 
+func TestMapFold(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	if actualValue, expectedValue := m.Fold(10, func(accumulator interface{}, key interface{}, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}), 20; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Reduce(func(accumulator interface{}, key interface{}, value interface{}) interface{} {
+		return accumulator.(int) * value.(int)
+	}), 24; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	empty := New()
+	if actualValue, expectedValue := empty.Fold(10, func(accumulator interface{}, key interface{}, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := empty.Reduce(func(accumulator interface{}, key interface{}, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestMapCount(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	if actualValue, expectedValue := m.Count(func(key interface{}, value interface{}) bool {
+		return value.(int)%2 == 0
+	}), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapGroupBy(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	groups := hashmap.New()
+	m.GroupBy(func(key interface{}, value interface{}) interface{} {
+		return value.(int)%2 == 0
+	}, groups)
+	if actualValue, expectedValue := groups.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tests := [][]interface{}{
+		{true, "[2 4]"},
+		{false, "[1 3]"},
+	}
+	for _, test := range tests {
+		group, _ := groups.Get(test[0])
+		if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(group.(*arraylist.List), utils.IntComparator)), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestMapPartition(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	selected, rest := m.Partition(func(key interface{}, value interface{}) bool {
+		return value.(int)%2 == 0
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(selected, utils.IntComparator)), "[2 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(rest, utils.IntComparator)), "[1 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapMinByMaxBy(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	if key, value := m.MinBy(utils.IntComparator); key != "a" || value != 1 {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, "a", 1)
+	}
+	if key, value := m.MaxBy(utils.IntComparator); key != "d" || value != 4 {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, "d", 4)
+	}
+	empty := New()
+	if key, value := empty.MinBy(utils.IntComparator); key != nil || value != nil {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, nil, nil)
+	}
+	if key, value := empty.MaxBy(utils.IntComparator); key != nil || value != nil {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, nil, nil)
+	}
+}
+
+func TestMapSum(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	if actualValue, expectedValue := m.SumInt(func(key interface{}, value interface{}) int {
+		return value.(int)
+	}), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.SumFloat64(func(key interface{}, value interface{}) float64 {
+		return float64(value.(int)) / 2
+	}), 5.0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapDistinct(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 1)
+	distinct := m.Distinct()
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(distinct, utils.IntComparator)), "[1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestMapChaining(t *testing.T) {
 	m := New()
 	m.Put("c", 3)
@@ -597,7 +736,7 @@ func TestMapString(t *testing.T) {
 	}
 }
 
-//noinspection GoBoolExpressions
+// noinspection GoBoolExpressions
 func assertSerialization(m *Map, txt string, t *testing.T) {
 	if actualValue := m.Keys(); false ||
 		actualValue[0].(string) != "d" ||
@@ -620,6 +759,188 @@ func assertSerialization(m *Map, txt string, t *testing.T) {
 	}
 }
 
+func TestMapIteratorConcurrentModification(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+
+	// modifications before the first element are allowed
+	it := m.Iterator()
+	m.Put("d", 4)
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, m.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// modifications during iteration panic
+	func() {
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		it := m.Iterator()
+		it.Next()
+		m.Remove("a")
+		it.Next()
+	}()
+
+	// checked iterator stops and reports the error
+	checked := m.CheckedIterator()
+	checked.Next()
+	m.Put("e", 5)
+	if checked.Next() {
+		t.Errorf("Should not iterate after modification")
+	}
+	if actualValue, expectedValue := checked.Err(), containers.ErrConcurrentModification; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checked.Begin()
+	if actualValue := checked.Err(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	count = 0
+	for checked.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, m.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapIteratorClone(t *testing.T) {
+	m := New()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+
+	it := m.Iterator()
+	it.Next()
+	clone := it.Clone()
+	first := it.Key()
+	it.Next()
+	if actualValue, expectedValue := clone.Key(), first; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !clone.Next() || clone.Key() != it.Key() {
+		t.Errorf("Got %v expected %v", clone.Key(), it.Key())
+	}
+	clone.Next()
+	if clone.Next() {
+		t.Errorf("Should not go past the last element")
+	}
+	if !it.Next() {
+		t.Errorf("Should move independently of the clone")
+	}
+}
+
+func TestMapIteratorRemove(t *testing.T) {
+	m := New()
+	for i := 1; i <= 100; i++ {
+		m.Put(i, i)
+	}
+	it := m.Iterator()
+	if it.Remove() {
+		t.Errorf("Should not remove before the first element")
+	}
+	for it.Next() {
+		if it.Key().(int)%3 == 0 && !it.Remove() {
+			t.Errorf("Should remove %v", it.Key())
+		}
+	}
+	for it.Prev() {
+		if it.Key().(int)%5 == 0 && !it.Remove() {
+			t.Errorf("Should remove %v", it.Key())
+		}
+	}
+	expected := []interface{}{}
+	for i := 1; i <= 100; i++ {
+		if i%3 != 0 && i%5 != 0 {
+			expected = append(expected, i)
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), fmt.Sprintf("%v", expected); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Size(), len(expected); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+}
+
+func TestMapIteratorRemoveNeighbours(t *testing.T) {
+	m := New()
+	for i := 1; i <= 100; i++ {
+		if i%3 != 0 && i%5 != 0 {
+			m.Put(i, i)
+		}
+	}
+	it := m.Iterator()
+
+	// the iterator is left between the neighbours of the removed element
+	it.First()
+	it.Next()
+	it.Remove()
+	if !it.Prev() || it.Key() != 1 {
+		t.Errorf("Got %v expected %v", it.Key(), 1)
+	}
+	if !it.Next() || it.Key() != 4 {
+		t.Errorf("Got %v expected %v", it.Key(), 4)
+	}
+	it.Last()
+	it.Remove()
+	if it.Next() {
+		t.Errorf("Should not move past the last element")
+	}
+	if !it.Prev() || it.Key() != 97 {
+		t.Errorf("Got %v expected %v", it.Key(), 97)
+	}
+}
+
+func TestMapSeq(t *testing.T) {
+	m := New()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	pairs := []string{}
+	for key, value := range m.AllSeq() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "c:3 a:1 b:2"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(m.KeysSeq())), "[c a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(m.ValuesSeq())), "[3 1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	pairs = []string{}
+	for key, value := range m.Backward() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "b:2 a:1 c:3"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	count := 0
+	for key := range m.KeysSeq() {
+		if key == "a" {
+			break
+		}
+		count++
+	}
+	if actualValue, expectedValue := count, 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func benchmarkGet(b *testing.B, m *Map, size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
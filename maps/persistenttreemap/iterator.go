@@ -0,0 +1,165 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package persistenttreemap
+
+import (
+	"iter"
+
+	"github.com/uncle-gua/gods/containers"
+	prbt "github.com/uncle-gua/gods/trees/persistentredblacktree"
+)
+
+// Assert Iterator implementation
+var _ containers.ReverseIteratorWithKey = (*Iterator)(nil)
+
+// Iterator holding the iterator's state
+type Iterator struct {
+	iterator prbt.Iterator
+}
+
+// Iterator returns a stateful iterator whose elements are key/value pairs.
+// The version of the map never changes, so the iterator stays valid regardless of new versions.
+func (m *Map) Iterator() Iterator {
+	return Iterator{iterator: m.tree.Iterator()}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator) Next() bool {
+	return iterator.iterator.Next()
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) Prev() bool {
+	return iterator.iterator.Prev()
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Value() interface{} {
+	return iterator.iterator.Value()
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Key() interface{} {
+	return iterator.iterator.Key()
+}
+
+// Clone returns an independent copy of the iterator at the same position, e.g. for lookahead or backtracking.
+// Moving either of the iterators does not move the other one.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Clone() Iterator {
+	return Iterator{iterator: iterator.iterator.Clone()}
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator) Begin() {
+	iterator.iterator.Begin()
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *Iterator) End() {
+	iterator.iterator.End()
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator
+func (iterator *Iterator) First() bool {
+	return iterator.iterator.First()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the container.
+// If Last() returns true, then last element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) Last() bool {
+	return iterator.iterator.Last()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If NextTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) NextTo(f func(key interface{}, value interface{}) bool) bool {
+	for iterator.Next() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// PrevTo moves the iterator to the previous element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If PrevTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) PrevTo(f func(key interface{}, value interface{}) bool) bool {
+	for iterator.Prev() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllSeq returns an iterator over key-value pairs of the map in order, for use with range-over-func.
+func (m *Map) AllSeq() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		iterator := m.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Key(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns an iterator over keys of the map in order, for use with range-over-func.
+// It is not named Keys, which returns the keys as a slice.
+func (m *Map) KeysSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		iterator := m.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Key()) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over values of the map in order of their keys, for use with range-over-func.
+// It is not named Values, which returns the values as a slice.
+func (m *Map) ValuesSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		iterator := m.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over key-value pairs of the map in reverse order, for use with range-over-func.
+func (m *Map) Backward() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		iterator := m.Iterator()
+		iterator.End()
+		for iterator.Prev() {
+			if !yield(iterator.Key(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package persistenttreemap implements a persistent (immutable) map backed by a persistent red-black tree.
+//
+// Elements are ordered by key in the map.
+//
+// Put, Remove and Clear do not modify the map, but return a new version of it that shares most of its structure with
+// the old version, so that both stay valid. Keeping a version is a snapshot in constant time, and any version can be
+// read by any number of goroutines concurrently without locking.
+//
+// Reference: https://en.wikipedia.org/wiki/Persistent_data_structure
+package persistenttreemap
+
+import (
+	"fmt"
+	"strings"
+
+	prbt "github.com/uncle-gua/gods/trees/persistentredblacktree"
+	"github.com/uncle-gua/gods/utils"
+)
+
+// Map is a version of the persistent map
+type Map struct {
+	tree *prbt.Tree
+}
+
+// NewWith instantiates an empty persistent tree map with the custom comparator.
+func NewWith(comparator utils.Comparator) *Map {
+	return &Map{tree: prbt.NewWith(comparator)}
+}
+
+// NewWithIntComparator instantiates an empty persistent tree map with the IntComparator, i.e. keys are of type int.
+func NewWithIntComparator() *Map {
+	return &Map{tree: prbt.NewWithIntComparator()}
+}
+
+// NewWithStringComparator instantiates an empty persistent tree map with the StringComparator, i.e. keys are of type string.
+func NewWithStringComparator() *Map {
+	return &Map{tree: prbt.NewWithStringComparator()}
+}
+
+// Put returns a new version of the map with the key-value pair inserted, or with the key's value replaced.
+// The map itself is not modified.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Put(key interface{}, value interface{}) *Map {
+	return &Map{tree: m.tree.Put(key, value)}
+}
+
+// Get searches the element in the map by key and returns its value or nil if key is not found in tree.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Get(key interface{}) (value interface{}, found bool) {
+	return m.tree.Get(key)
+}
+
+// Remove returns a new version of the map without the key, or the map itself if the key is not found in map.
+// The map itself is not modified.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Remove(key interface{}) *Map {
+	if tree := m.tree.Remove(key); tree != m.tree {
+		return &Map{tree: tree}
+	}
+	return m
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map) Empty() bool {
+	return m.tree.Empty()
+}
+
+// Size returns number of elements in the map.
+func (m *Map) Size() int {
+	return m.tree.Size()
+}
+
+// Keys returns all keys in-order
+func (m *Map) Keys() []interface{} {
+	return m.tree.Keys()
+}
+
+// Values returns all values in-order based on the key.
+func (m *Map) Values() []interface{} {
+	return m.tree.Values()
+}
+
+// Clear returns an empty version of the map with the same comparator.
+// The map itself is not modified.
+func (m *Map) Clear() *Map {
+	return &Map{tree: m.tree.Clear()}
+}
+
+// Min returns the minimum key and its value from the tree map.
+// Returns nil, nil if map is empty.
+func (m *Map) Min() (key interface{}, value interface{}) {
+	if node := m.tree.Left(); node != nil {
+		return node.Key, node.Value
+	}
+	return nil, nil
+}
+
+// Max returns the maximum key and its value from the tree map.
+// Returns nil, nil if map is empty.
+func (m *Map) Max() (key interface{}, value interface{}) {
+	if node := m.tree.Right(); node != nil {
+		return node.Key, node.Value
+	}
+	return nil, nil
+}
+
+// Floor finds the floor key-value pair for the input key.
+// In case that no floor is found, then both returned values will be nil.
+// It's generally enough to check the first value (key) for nil, which determines if floor was found.
+//
+// Floor key is defined as the largest key that is smaller than or equal to the given key.
+// A floor key may not be found, either because the map is empty, or because
+// all keys in the map are larger than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Floor(key interface{}) (foundKey interface{}, foundValue interface{}) {
+	node, found := m.tree.Floor(key)
+	if found {
+		return node.Key, node.Value
+	}
+	return nil, nil
+}
+
+// Ceiling finds the ceiling key-value pair for the input key.
+// In case that no ceiling is found, then both returned values will be nil.
+// It's generally enough to check the first value (key) for nil, which determines if ceiling was found.
+//
+// Ceiling key is defined as the smallest key that is larger than or equal to the given key.
+// A ceiling key may not be found, either because the map is empty, or because
+// all keys in the map are smaller than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Ceiling(key interface{}) (foundKey interface{}, foundValue interface{}) {
+	node, found := m.tree.Ceiling(key)
+	if found {
+		return node.Key, node.Value
+	}
+	return nil, nil
+}
+
+// String returns a string representation of container
+func (m *Map) String() string {
+	str := "PersistentTreeMap\nmap["
+	it := m.Iterator()
+	for it.Next() {
+		str += fmt.Sprintf("%v:%v ", it.Key(), it.Value())
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package persistenttreemap
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestMapPut(t *testing.T) {
+	m0 := NewWithIntComparator()
+	m1 := m0.Put(5, "e").Put(6, "f").Put(7, "g").Put(3, "c").Put(4, "d").Put(1, "x").Put(2, "b")
+	m2 := m1.Put(1, "a") //overwrite
+
+	tests1 := [][]interface{}{
+		{m0, 0, "[]", "[]"},
+		{m1, 7, "[1 2 3 4 5 6 7]", "[x b c d e f g]"},
+		{m2, 7, "[1 2 3 4 5 6 7]", "[a b c d e f g]"},
+	}
+	for _, test := range tests1 {
+		m := test[0].(*Map)
+		if actualValue, expectedValue := m.Size(), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", m.Values()), test[3]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	tests2 := [][]interface{}{
+		{1, "a", true},
+		{2, "b", true},
+		{7, "g", true},
+		{8, nil, false},
+	}
+	for _, test := range tests2 {
+		actualValue, actualFound := m2.Get(test[0])
+		if actualValue != test[1] || actualFound != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+}
+
+func TestMapRemove(t *testing.T) {
+	m1 := NewWithIntComparator().Put(5, "e").Put(6, "f").Put(7, "g").Put(3, "c").Put(4, "d").Put(1, "x").Put(2, "b")
+	m2 := m1.Remove(5).Remove(6).Remove(7).Remove(8).Remove(5)
+	m3 := m2.Clear()
+
+	tests := [][]interface{}{
+		{m1, 7, "[1 2 3 4 5 6 7]"},
+		{m2, 4, "[1 2 3 4]"},
+		{m3, 0, "[]"},
+	}
+	for _, test := range tests {
+		m := test[0].(*Map)
+		if actualValue, expectedValue := m.Size(), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue := m2.Remove(8); actualValue != m2 {
+		t.Errorf("Got %v expected %v", actualValue, m2)
+	}
+	if actualValue := m3.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestMapMinMaxFloorCeiling(t *testing.T) {
+	m := NewWithIntComparator()
+
+	if key, value := m.Min(); key != nil || value != nil {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, nil, nil)
+	}
+	if key, value := m.Max(); key != nil || value != nil {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, nil, nil)
+	}
+
+	m = m.Put(7, "g").Put(3, "c").Put(1, "a")
+
+	tests := [][]interface{}{
+		{m.Min, 1, "a"},
+		{m.Max, 7, "g"},
+		{func() (interface{}, interface{}) { return m.Floor(4) }, 3, "c"},
+		{func() (interface{}, interface{}) { return m.Floor(0) }, nil, nil},
+		{func() (interface{}, interface{}) { return m.Ceiling(4) }, 7, "g"},
+		{func() (interface{}, interface{}) { return m.Ceiling(8) }, nil, nil},
+	}
+	for _, test := range tests {
+		key, value := test[0].(func() (interface{}, interface{}))()
+		if key != test[1] || value != test[2] {
+			t.Errorf("Got %v->%v expected %v->%v", key, value, test[1], test[2])
+		}
+	}
+}
+
+func TestMapIterator(t *testing.T) {
+	m := NewWithStringComparator().Put("c", 3).Put("a", 1).Put("b", 2)
+	snapshot := m
+	m = m.Remove("a").Put("d", 4)
+
+	it := snapshot.Iterator()
+	entries := []string{}
+	for it.Next() {
+		entries = append(entries, fmt.Sprintf("%v:%v", it.Key(), it.Value()))
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", entries), "[a:1 b:2 c:3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	entries = []string{}
+	for it.Prev() {
+		entries = append(entries, fmt.Sprintf("%v:%v", it.Key(), it.Value()))
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", entries), "[c:3 b:2 a:1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	it = m.Iterator()
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue || it.Key() != "d" {
+		t.Errorf("Got %v expected %v", it.Key(), "d")
+	}
+	clone := it.Clone()
+	if actualValue, expectedValue := clone.PrevTo(func(key interface{}, value interface{}) bool {
+		return value.(int) < 3
+	}), true; actualValue != expectedValue || clone.Key() != "b" || it.Key() != "d" {
+		t.Errorf("Got %v and %v expected %v and %v", clone.Key(), it.Key(), "b", "d")
+	}
+}
+
+func TestMapSeq(t *testing.T) {
+	m := NewWithIntComparator().Put(2, "b").Put(1, "a").Put(3, "c")
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(m.KeysSeq())), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(m.ValuesSeq())), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	keys := []interface{}{}
+	for key := range m.Backward() {
+		keys = append(keys, key)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapString(t *testing.T) {
+	m := NewWithStringComparator().Put("a", 1)
+	if actualValue, expectedValue := m.String(), "PersistentTreeMap\nmap[a:1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package skiplistmap
+
+import (
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/lists/arraylist"
+	"github.com/uncle-gua/gods/utils"
+)
+
+// Assert Enumerable implementation
+var _ containers.EnumerableWithKey = (*Map)(nil)
+
+// Each calls the given function once for each element, passing that element's key and value.
+func (m *Map) Each(f func(key interface{}, value interface{})) {
+	iterator := m.Iterator()
+	for iterator.Next() {
+		f(iterator.Key(), iterator.Value())
+	}
+}
+
+// Map invokes the given function once for each element and returns a container
+// containing the values returned by the given function as key/value pairs.
+func (m *Map) Map(f func(key1 interface{}, value1 interface{}) (interface{}, interface{})) *Map {
+	newMap := m.empty()
+	iterator := m.Iterator()
+	for iterator.Next() {
+		key2, value2 := f(iterator.Key(), iterator.Value())
+		newMap.Put(key2, value2)
+	}
+	return newMap
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+func (m *Map) Select(f func(key interface{}, value interface{}) bool) *Map {
+	newMap := m.empty()
+	iterator := m.Iterator()
+	for iterator.Next() {
+		if f(iterator.Key(), iterator.Value()) {
+			newMap.Put(iterator.Key(), iterator.Value())
+		}
+	}
+	return newMap
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+func (m *Map) Any(f func(key interface{}, value interface{}) bool) bool {
+	iterator := m.Iterator()
+	for iterator.Next() {
+		if f(iterator.Key(), iterator.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+func (m *Map) All(f func(key interface{}, value interface{}) bool) bool {
+	iterator := m.Iterator()
+	for iterator.Next() {
+		if !f(iterator.Key(), iterator.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (key,value) for which the function is true or nil,nil otherwise if no element
+// matches the criteria.
+func (m *Map) Find(f func(key interface{}, value interface{}) bool) (interface{}, interface{}) {
+	iterator := m.Iterator()
+	for iterator.Next() {
+		if f(iterator.Key(), iterator.Value()) {
+			return iterator.Key(), iterator.Value()
+		}
+	}
+	return nil, nil
+}
+
+// Fold passes each element of the container to the given function together with the accumulated value,
+// starting with the initial value, and returns the last value returned by the function (or the initial value).
+func (m *Map) Fold(initial interface{}, f func(accumulator interface{}, key interface{}, value interface{}) interface{}) interface{} {
+	accumulator := initial
+	iterator := m.Iterator()
+	for iterator.Next() {
+		accumulator = f(accumulator, iterator.Key(), iterator.Value())
+	}
+	return accumulator
+}
+
+// Reduce works like Fold, starting with the first element's value as the accumulated value,
+// and returns nil if the container is empty.
+func (m *Map) Reduce(f func(accumulator interface{}, key interface{}, value interface{}) interface{}) interface{} {
+	iterator := m.Iterator()
+	if !iterator.Next() {
+		return nil
+	}
+	accumulator := iterator.Value()
+	for iterator.Next() {
+		accumulator = f(accumulator, iterator.Key(), iterator.Value())
+	}
+	return accumulator
+}
+
+// Count returns the number of elements for which the given function returns a true value.
+func (m *Map) Count(f func(key interface{}, value interface{}) bool) int {
+	count := 0
+	iterator := m.Iterator()
+	for iterator.Next() {
+		if f(iterator.Key(), iterator.Value()) {
+			count++
+		}
+	}
+	return count
+}
+
+// GroupBy puts each element into a group stored in the given map under the key returned by the given function.
+// Groups are new array lists of the values in iteration order, the map should not hold any other values.
+func (m *Map) GroupBy(f func(key interface{}, value interface{}) interface{}, groups containers.Groups) {
+	iterator := m.Iterator()
+	for iterator.Next() {
+		key := f(iterator.Key(), iterator.Value())
+		group, found := groups.Get(key)
+		if !found {
+			group = arraylist.New()
+			groups.Put(key, group)
+		}
+		group.(*arraylist.List).Add(iterator.Value())
+	}
+}
+
+// Partition returns a new map containing all elements for which the given function returns a true value
+// and a new map containing the rest of the elements.
+func (m *Map) Partition(f func(key interface{}, value interface{}) bool) (containers.Container, containers.Container) {
+	selected, rest := m.empty(), m.empty()
+	iterator := m.Iterator()
+	for iterator.Next() {
+		if f(iterator.Key(), iterator.Value()) {
+			selected.Put(iterator.Key(), iterator.Value())
+		} else {
+			rest.Put(iterator.Key(), iterator.Value())
+		}
+	}
+	return selected, rest
+}
+
+// MinBy returns the first smallest (key,value) according to the given comparator of values
+// or nil,nil if the container is empty.
+func (m *Map) MinBy(comparator utils.Comparator) (interface{}, interface{}) {
+	var key, value interface{}
+	found := false
+	iterator := m.Iterator()
+	for iterator.Next() {
+		if !found || comparator(iterator.Value(), value) < 0 {
+			key, value, found = iterator.Key(), iterator.Value(), true
+		}
+	}
+	return key, value
+}
+
+// MaxBy returns the first largest (key,value) according to the given comparator of values
+// or nil,nil if the container is empty.
+func (m *Map) MaxBy(comparator utils.Comparator) (interface{}, interface{}) {
+	var key, value interface{}
+	found := false
+	iterator := m.Iterator()
+	for iterator.Next() {
+		if !found || comparator(iterator.Value(), value) > 0 {
+			key, value, found = iterator.Key(), iterator.Value(), true
+		}
+	}
+	return key, value
+}
+
+// SumInt returns the sum of the numbers returned by the given function for each element.
+func (m *Map) SumInt(f func(key interface{}, value interface{}) int) int {
+	var sum int
+	iterator := m.Iterator()
+	for iterator.Next() {
+		sum += f(iterator.Key(), iterator.Value())
+	}
+	return sum
+}
+
+// SumFloat64 returns the sum of the numbers returned by the given function for each element.
+func (m *Map) SumFloat64(f func(key interface{}, value interface{}) float64) float64 {
+	var sum float64
+	iterator := m.Iterator()
+	for iterator.Next() {
+		sum += f(iterator.Key(), iterator.Value())
+	}
+	return sum
+}
+
+// Distinct returns a new map containing the key/value pairs without repeated values, keeping the first pair for each value.
+// Values must be comparable with == (i.e. usable as map keys), otherwise the method panics.
+func (m *Map) Distinct() containers.Container {
+	newMap := m.empty()
+	seen := make(map[interface{}]struct{})
+	iterator := m.Iterator()
+	for iterator.Next() {
+		if _, found := seen[iterator.Value()]; !found {
+			seen[iterator.Value()] = struct{}{}
+			newMap.Put(iterator.Key(), iterator.Value())
+		}
+	}
+	return newMap
+}
@@ -0,0 +1,273 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package skiplistmap
+
+import (
+	"iter"
+
+	"github.com/uncle-gua/gods/containers"
+)
+
+// Assert Iterator implementation
+var _ containers.ReverseIteratorWithKey = (*Iterator)(nil)
+
+// Iterator holding the iterator's state
+type Iterator struct {
+	m        *Map
+	node     *node
+	position position
+	failFast containers.FailFast
+}
+
+type position byte
+
+const (
+	begin, between, end position = 0, 1, 2
+)
+
+// Iterator returns a stateful iterator whose elements are key/value pairs.
+// Moving the iterator follows the links on the lowest level of the skip list, i.e. it takes constant time.
+// The iterator fails fast: moving it after the map was structurally modified other than through the iterator
+// panics with containers.ErrConcurrentModification. Modifications made while the iterator is before the first
+// or past the last element, e.g. after Begin() or End(), are allowed.
+func (m *Map) Iterator() Iterator {
+	return Iterator{m: m, node: nil, position: begin, failFast: containers.NewFailFast(m.modCount)}
+}
+
+// CheckedIterator returns a stateful iterator like Iterator(), except that instead of panicking when the map was
+// modified during iteration, it stops and reports containers.ErrConcurrentModification by Err().
+func (m *Map) CheckedIterator() Iterator {
+	iterator := m.Iterator()
+	iterator.failFast.Check()
+	return iterator
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator) Next() bool {
+	if !iterator.failFast.Move(iterator.m.modCount, iterator.position != between) {
+		return false
+	}
+	switch iterator.position {
+	case end:
+		return false
+	case begin:
+		iterator.node = iterator.m.head.next[0]
+	default:
+		iterator.node = iterator.node.next[0]
+	}
+	if iterator.node == nil {
+		iterator.End()
+		return false
+	}
+	iterator.position = between
+	return true
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) Prev() bool {
+	if !iterator.failFast.Move(iterator.m.modCount, iterator.position != between) {
+		return false
+	}
+	switch iterator.position {
+	case begin:
+		return false
+	case end:
+		iterator.node = iterator.m.tail
+	default:
+		iterator.node = iterator.node.prev
+	}
+	if iterator.node == nil {
+		iterator.Begin()
+		return false
+	}
+	iterator.position = between
+	return true
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Value() interface{} {
+	return iterator.node.value
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Key() interface{} {
+	return iterator.node.key
+}
+
+// Clone returns an independent copy of the iterator at the same position, e.g. for lookahead or backtracking.
+// Moving either of the iterators does not move the other one.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Clone() Iterator {
+	return *iterator
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator) Begin() {
+	iterator.node = nil
+	iterator.position = begin
+	iterator.sync()
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *Iterator) End() {
+	iterator.node = nil
+	iterator.position = end
+	iterator.sync()
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator
+func (iterator *Iterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the container.
+// If Last() returns true, then last element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If NextTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) NextTo(f func(key interface{}, value interface{}) bool) bool {
+	for iterator.Next() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// PrevTo moves the iterator to the previous element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If PrevTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) PrevTo(f func(key interface{}, value interface{}) bool) bool {
+	for iterator.Prev() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// SeekGE moves the iterator to the first element whose key is greater than or equal to the given key and returns
+// true if there was such an element, otherwise moves the iterator past the last element.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekGE(key interface{}) bool {
+	return iterator.seek(iterator.m.ceilingNode(key, true), end)
+}
+
+// SeekGT moves the iterator to the first element whose key is greater than the given key and returns
+// true if there was such an element, otherwise moves the iterator past the last element.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekGT(key interface{}) bool {
+	return iterator.seek(iterator.m.ceilingNode(key, false), end)
+}
+
+// SeekLE moves the iterator to the last element whose key is smaller than or equal to the given key and returns
+// true if there was such an element, otherwise moves the iterator before the first element.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekLE(key interface{}) bool {
+	return iterator.seek(iterator.m.floorNode(key, true), begin)
+}
+
+// SeekLT moves the iterator to the last element whose key is smaller than the given key and returns
+// true if there was such an element, otherwise moves the iterator before the first element.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekLT(key interface{}) bool {
+	return iterator.seek(iterator.m.floorNode(key, false), begin)
+}
+
+// seek moves the iterator to the node, or to the given position if there is no node.
+func (iterator *Iterator) seek(node *node, otherwise position) bool {
+	iterator.sync()
+	if node == nil {
+		iterator.node, iterator.position = nil, otherwise
+		return false
+	}
+	iterator.node, iterator.position = node, between
+	return true
+}
+
+// Err returns containers.ErrConcurrentModification if the checked iterator stopped because the map was modified
+// during iteration, otherwise nil.
+func (iterator *Iterator) Err() error {
+	return iterator.failFast.Err()
+}
+
+// sync accepts the current state of the map, i.e. after the iterator was reset.
+func (iterator *Iterator) sync() {
+	iterator.failFast.Sync(iterator.m.modCount)
+}
+
+// AllSeq returns an iterator over key-value pairs of the map in order, for use with range-over-func.
+// It is not named All, which is the predicate of Enumerable.
+func (m *Map) AllSeq() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		iterator := m.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Key(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns an iterator over keys of the map in order, for use with range-over-func.
+// It is not named Keys, which returns the keys as a slice.
+func (m *Map) KeysSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		iterator := m.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Key()) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over values of the map in order of their keys, for use with range-over-func.
+// It is not named Values, which returns the values as a slice.
+func (m *Map) ValuesSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		iterator := m.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over key-value pairs of the map in reverse order, for use with range-over-func.
+func (m *Map) Backward() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		iterator := m.Iterator()
+		iterator.End()
+		for iterator.Prev() {
+			if !yield(iterator.Key(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package skiplistmap
+
+import (
+	"encoding/json"
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/utils"
+)
+
+// Assert Serialization implementation
+var _ containers.JSONSerializer = (*Map)(nil)
+var _ containers.JSONDeserializer = (*Map)(nil)
+
+// ToJSON outputs the JSON representation of the map.
+func (m *Map) ToJSON() ([]byte, error) {
+	elements := make(map[string]interface{})
+	for node := m.head.next[0]; node != nil; node = node.next[0] {
+		elements[utils.ToString(node.key)] = node.value
+	}
+	return json.Marshal(&elements)
+}
+
+// FromJSON populates the map from the input JSON representation.
+// The keys are put in order, so that the structure does not depend on the order of the keys in the input.
+func (m *Map) FromJSON(data []byte) error {
+	elements := make(map[string]interface{})
+	err := json.Unmarshal(data, &elements)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(elements))
+	for key := range elements {
+		keys = append(keys, key)
+	}
+	utils.Sort(keys, m.Comparator)
+	m.Clear()
+	for _, key := range keys {
+		m.Put(key, elements[key.(string)])
+	}
+	return nil
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (m *Map) UnmarshalJSON(bytes []byte) error {
+	return m.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (m *Map) MarshalJSON() ([]byte, error) {
+	return m.ToJSON()
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package skiplistmap implements a map backed by a skip list.
+//
+// Elements are ordered by key in the map.
+//
+// The elements are linked in order on the lowest level of the skip list and each element is also linked on a random
+// number of the levels above it, so that a search can skip ahead on the higher levels. Searches, insertions and
+// removals take expected O(log n) time and only relink the neighbours of the element, there are no rotations.
+// The random levels are drawn from a generator that can be seeded for reproducible structures, e.g. in tests.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Skip_list
+package skiplistmap
+
+import (
+	"fmt"
+	"github.com/uncle-gua/gods/maps"
+	"github.com/uncle-gua/gods/utils"
+	"math/rand"
+	"strings"
+)
+
+// Assert Map implementation
+var _ maps.Map = (*Map)(nil)
+
+// maxLevel is the number of levels of the skip list, enough for 2^maxLevel elements.
+const maxLevel = 32
+
+// Map holds the elements in a skip list
+type Map struct {
+	head       *node // sentinel holding the links to the first element on each level
+	tail       *node // last element, nil if the map is empty
+	level      int   // number of levels in use
+	size       int
+	Comparator utils.Comparator
+	random     *rand.Rand
+	modCount   int // number of structural modifications, checked by iterators
+}
+
+type node struct {
+	key   interface{}
+	value interface{}
+	next  []*node // successors on the levels of the element
+	prev  *node   // predecessor on the lowest level, nil for the first element
+}
+
+// NewWith instantiates a skip list map with the custom comparator.
+func NewWith(comparator utils.Comparator) *Map {
+	return NewWithSeed(comparator, rand.Int63())
+}
+
+// NewWithIntComparator instantiates a skip list map with the IntComparator, i.e. keys are of type int.
+func NewWithIntComparator() *Map {
+	return NewWith(utils.IntComparator)
+}
+
+// NewWithStringComparator instantiates a skip list map with the StringComparator, i.e. keys are of type string.
+func NewWithStringComparator() *Map {
+	return NewWith(utils.StringComparator)
+}
+
+// NewWithSeed instantiates a skip list map with the custom comparator, whose levels are drawn from a generator
+// with the given seed, i.e. maps with the same seed and the same modifications have the same structure.
+func NewWithSeed(comparator utils.Comparator, seed int64) *Map {
+	return &Map{
+		head:       &node{next: make([]*node, maxLevel)},
+		Comparator: comparator,
+		random:     rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Put inserts key-value pair into the map in expected O(log n) time.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Put(key interface{}, value interface{}) {
+	update := m.predecessors(key)
+	if found := update[0].next[0]; found != nil && m.Comparator(found.key, key) == 0 {
+		found.key = key
+		found.value = value
+		return
+	}
+	level := m.randomLevel()
+	for ; m.level < level; m.level++ {
+		update[m.level] = m.head
+	}
+	inserted := &node{key: key, value: value, next: make([]*node, level)}
+	for i := 0; i < level; i++ {
+		inserted.next[i] = update[i].next[i]
+		update[i].next[i] = inserted
+	}
+	if update[0] != m.head {
+		inserted.prev = update[0]
+	}
+	if inserted.next[0] != nil {
+		inserted.next[0].prev = inserted
+	} else {
+		m.tail = inserted
+	}
+	m.size++
+	m.modCount++
+}
+
+// Get searches the element in the map by key and returns its value or nil if key is not found in map.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Get(key interface{}) (value interface{}, found bool) {
+	if node := m.ceilingNode(key, true); node != nil && m.Comparator(node.key, key) == 0 {
+		return node.value, true
+	}
+	return nil, false
+}
+
+// Remove removes the element from the map by key in expected O(log n) time.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Remove(key interface{}) {
+	update := m.predecessors(key)
+	removed := update[0].next[0]
+	if removed == nil || m.Comparator(removed.key, key) != 0 {
+		return
+	}
+	for i := range removed.next {
+		update[i].next[i] = removed.next[i]
+	}
+	if removed.next[0] != nil {
+		removed.next[0].prev = removed.prev
+	} else {
+		m.tail = removed.prev
+	}
+	for m.level > 0 && m.head.next[m.level-1] == nil {
+		m.level--
+	}
+	m.size--
+	m.modCount++
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map) Empty() bool {
+	return m.size == 0
+}
+
+// Size returns number of elements in the map.
+func (m *Map) Size() int {
+	return m.size
+}
+
+// Keys returns all keys in-order
+func (m *Map) Keys() []interface{} {
+	keys := make([]interface{}, 0, m.size)
+	for node := m.head.next[0]; node != nil; node = node.next[0] {
+		keys = append(keys, node.key)
+	}
+	return keys
+}
+
+// Values returns all values in-order based on the key.
+func (m *Map) Values() []interface{} {
+	values := make([]interface{}, 0, m.size)
+	for node := m.head.next[0]; node != nil; node = node.next[0] {
+		values = append(values, node.value)
+	}
+	return values
+}
+
+// Clear removes all elements from the map.
+func (m *Map) Clear() {
+	m.head = &node{next: make([]*node, maxLevel)}
+	m.tail = nil
+	m.level = 0
+	m.size = 0
+	m.modCount++
+}
+
+// Min returns the minimum key and its value from the map.
+// Returns nil, nil if map is empty.
+func (m *Map) Min() (key interface{}, value interface{}) {
+	if node := m.head.next[0]; node != nil {
+		return node.key, node.value
+	}
+	return nil, nil
+}
+
+// Max returns the maximum key and its value from the map.
+// Returns nil, nil if map is empty.
+func (m *Map) Max() (key interface{}, value interface{}) {
+	if node := m.tail; node != nil {
+		return node.key, node.value
+	}
+	return nil, nil
+}
+
+// Floor finds the floor key-value pair for the input key.
+// In case that no floor is found, then both returned values will be nil.
+// It's generally enough to check the first value (key) for nil, which determines if floor was found.
+//
+// Floor key is defined as the largest key that is smaller than or equal to the given key.
+// A floor key may not be found, either because the map is empty, or because
+// all keys in the map are larger than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Floor(key interface{}) (foundKey interface{}, foundValue interface{}) {
+	if node := m.floorNode(key, true); node != nil {
+		return node.key, node.value
+	}
+	return nil, nil
+}
+
+// Ceiling finds the ceiling key-value pair for the input key.
+// In case that no ceiling is found, then both returned values will be nil.
+// It's generally enough to check the first value (key) for nil, which determines if ceiling was found.
+//
+// Ceiling key is defined as the smallest key that is larger than or equal to the given key.
+// A ceiling key may not be found, either because the map is empty, or because
+// all keys in the map are smaller than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Ceiling(key interface{}) (foundKey interface{}, foundValue interface{}) {
+	if node := m.ceilingNode(key, true); node != nil {
+		return node.key, node.value
+	}
+	return nil, nil
+}
+
+// String returns a string representation of container
+func (m *Map) String() string {
+	str := "SkipListMap\nmap["
+	it := m.Iterator()
+	for it.Next() {
+		str += fmt.Sprintf("%v:%v ", it.Key(), it.Value())
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+// randomLevel returns the number of levels of a new element, which is at least one and one more with probability 1/2.
+func (m *Map) randomLevel() int {
+	level := 1
+	for level < maxLevel && m.random.Int63()&1 == 0 {
+		level++
+	}
+	return level
+}
+
+// predecessors returns the last node whose key is smaller than the key on each level in use, or the head.
+func (m *Map) predecessors(key interface{}) (update [maxLevel]*node) {
+	current := m.head
+	for i := m.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && m.Comparator(current.next[i].key, key) < 0 {
+			current = current.next[i]
+		}
+		update[i] = current
+	}
+	update[0] = current
+	return update
+}
+
+// predecessor returns the last node whose key is smaller than (or equal to, if inclusive) the key, or the head.
+func (m *Map) predecessor(key interface{}, inclusive bool) *node {
+	current := m.head
+	for i := m.level - 1; i >= 0; i-- {
+		for current.next[i] != nil {
+			compare := m.Comparator(current.next[i].key, key)
+			if compare > 0 || compare == 0 && !inclusive {
+				break
+			}
+			current = current.next[i]
+		}
+	}
+	return current
+}
+
+// floorNode returns the last node whose key is smaller than (or equal to, if inclusive) the key, or nil.
+func (m *Map) floorNode(key interface{}, inclusive bool) *node {
+	if node := m.predecessor(key, inclusive); node != m.head {
+		return node
+	}
+	return nil
+}
+
+// ceilingNode returns the first node whose key is greater than (or equal to, if inclusive) the key, or nil.
+func (m *Map) ceilingNode(key interface{}, inclusive bool) *node {
+	return m.predecessor(key, !inclusive).next[0]
+}
+
+// empty returns a new empty map with the same comparator, whose generator is seeded from the map's generator.
+func (m *Map) empty() *Map {
+	return NewWithSeed(m.Comparator, m.random.Int63())
+}
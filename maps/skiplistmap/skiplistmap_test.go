@@ -0,0 +1,1471 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package skiplistmap
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/lists/arraylist"
+	"github.com/uncle-gua/gods/maps/hashmap"
+	"github.com/uncle-gua/gods/utils"
+	"math"
+	"math/rand"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestMapPut(t *testing.T) {
+	m := NewWith(utils.IntComparator)
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(4, "d")
+	m.Put(1, "x")
+	m.Put(2, "b")
+	m.Put(1, "a") //overwrite
+
+	if actualValue := m.Size(); actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue, expectedValue := m.Keys(), []interface{}{1, 2, 3, 4, 5, 6, 7}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Values(), []interface{}{"a", "b", "c", "d", "e", "f", "g"}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// key,expectedValue,expectedFound
+	tests1 := [][]interface{}{
+		{1, "a", true},
+		{2, "b", true},
+		{3, "c", true},
+		{4, "d", true},
+		{5, "e", true},
+		{6, "f", true},
+		{7, "g", true},
+		{8, nil, false},
+	}
+
+	for _, test := range tests1 {
+		// retrievals
+		actualValue, actualFound := m.Get(test[0])
+		if actualValue != test[1] || actualFound != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+}
+
+func TestMapPutWithStringComparators(t *testing.T) {
+	m := NewWith(utils.NaturalStringComparator)
+	m.Put("file10", 10)
+	m.Put("file2", 2)
+	m.Put("file1", 1)
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[file1 file2 file10]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	m = NewWith(utils.CaseInsensitiveStringComparator)
+	m.Put("Apple", 1)
+	m.Put("banana", 2)
+	m.Put("apple", 3) //overwrite
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[apple banana]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := m.Get("APPLE"); actualValue != 3 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+
+	m = NewWith(utils.NormalizedStringComparator)
+	m.Put("caf\u00e9", 1)
+	m.Put("cafe\u0301", 2) //overwrite
+	if actualValue := m.Size(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, found := m.Get("caf\u00e9"); actualValue != 2 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
+
+func TestMapPutWithFloatKeys(t *testing.T) {
+	m := NewWith(utils.Float64TotalComparator)
+	m.Put(math.NaN(), "nan")
+	m.Put(1.0, "one")
+	m.Put(math.Copysign(0, -1), "-zero")
+	m.Put(0.0, "zero")
+	m.Put(math.NaN(), "NaN") //overwrite
+	m.Put(math.Inf(-1), "-inf")
+
+	if actualValue := m.Size(); actualValue != 5 {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Values()), "[-inf -zero zero one NaN]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := m.Get(math.NaN()); actualValue != "NaN" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "NaN")
+	}
+	m.Remove(math.NaN())
+	if actualValue, found := m.Get(math.NaN()); actualValue != nil || found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestMapPutWithReflectComparator(t *testing.T) {
+	type key struct {
+		tenant string
+		ts     int64
+	}
+	comparator, err := utils.ReflectComparator(reflect.TypeOf(key{}))
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	m := NewWith(comparator)
+	m.Put(key{"b", 1}, "b1")
+	m.Put(key{"a", 2}, "a2")
+	m.Put(key{"a", 1}, "a1")
+	m.Put(key{"b", 1}, "B1") //overwrite
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Values()), "[a1 a2 B1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := m.Get(key{"a", 2}); actualValue != "a2" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a2")
+	}
+}
+
+func TestMapMin(t *testing.T) {
+	m := NewWithIntComparator()
+
+	if k, v := m.Min(); k != nil || v != nil {
+		t.Errorf("Got %v->%v expected %v->%v", k, v, nil, nil)
+	}
+
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(4, "d")
+	m.Put(1, "x")
+	m.Put(2, "b")
+	m.Put(1, "a") //overwrite
+
+	actualKey, actualValue := m.Min()
+	expectedKey, expectedValue := 1, "a"
+	if actualKey != expectedKey {
+		t.Errorf("Got %v expected %v", actualKey, expectedKey)
+	}
+	if actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapMax(t *testing.T) {
+	m := NewWithIntComparator()
+
+	if k, v := m.Max(); k != nil || v != nil {
+		t.Errorf("Got %v->%v expected %v->%v", k, v, nil, nil)
+	}
+
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(4, "d")
+	m.Put(1, "x")
+	m.Put(2, "b")
+	m.Put(1, "a") //overwrite
+
+	actualKey, actualValue := m.Max()
+	expectedKey, expectedValue := 7, "g"
+	if actualKey != expectedKey {
+		t.Errorf("Got %v expected %v", actualKey, expectedKey)
+	}
+	if actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapClear(t *testing.T) {
+	m := NewWithIntComparator()
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(3, "c")
+	if actualValue, expectedValue := m.Size(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Clear()
+	if actualValue, expectedValue := m.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapRemove(t *testing.T) {
+	m := NewWithIntComparator()
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(4, "d")
+	m.Put(1, "x")
+	m.Put(2, "b")
+	m.Put(1, "a") //overwrite
+
+	m.Remove(5)
+	m.Remove(6)
+	m.Remove(7)
+	m.Remove(8)
+	m.Remove(5)
+
+	if actualValue, expectedValue := m.Keys(), []interface{}{1, 2, 3, 4}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue, expectedValue := m.Values(), []interface{}{"a", "b", "c", "d"}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := m.Size(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+
+	tests2 := [][]interface{}{
+		{1, "a", true},
+		{2, "b", true},
+		{3, "c", true},
+		{4, "d", true},
+		{5, nil, false},
+		{6, nil, false},
+		{7, nil, false},
+		{8, nil, false},
+	}
+
+	for _, test := range tests2 {
+		actualValue, actualFound := m.Get(test[0])
+		if actualValue != test[1] || actualFound != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+
+	m.Remove(1)
+	m.Remove(4)
+	m.Remove(2)
+	m.Remove(3)
+	m.Remove(2)
+	m.Remove(2)
+
+	if actualValue, expectedValue := fmt.Sprintf("%s", m.Keys()), "[]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%s", m.Values()), "[]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := m.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := m.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestMapFloor(t *testing.T) {
+	m := NewWithIntComparator()
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(1, "a")
+
+	// key,expectedKey,expectedValue,expectedFound
+	tests1 := [][]interface{}{
+		{-1, nil, nil, false},
+		{0, nil, nil, false},
+		{1, 1, "a", true},
+		{2, 1, "a", true},
+		{3, 3, "c", true},
+		{4, 3, "c", true},
+		{7, 7, "g", true},
+		{8, 7, "g", true},
+	}
+
+	for _, test := range tests1 {
+		// retrievals
+		actualKey, actualValue := m.Floor(test[0])
+		actualFound := actualKey != nil && actualValue != nil
+		if actualKey != test[1] || actualValue != test[2] || actualFound != test[3] {
+			t.Errorf("Got %v, %v, %v, expected %v, %v, %v", actualKey, actualValue, actualFound, test[1], test[2], test[3])
+		}
+	}
+}
+
+func TestMapCeiling(t *testing.T) {
+	m := NewWithIntComparator()
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(1, "a")
+
+	// key,expectedKey,expectedValue,expectedFound
+	tests1 := [][]interface{}{
+		{-1, 1, "a", true},
+		{0, 1, "a", true},
+		{1, 1, "a", true},
+		{2, 3, "c", true},
+		{3, 3, "c", true},
+		{4, 7, "g", true},
+		{7, 7, "g", true},
+		{8, nil, nil, false},
+	}
+
+	for _, test := range tests1 {
+		// retrievals
+		actualKey, actualValue := m.Ceiling(test[0])
+		actualFound := actualKey != nil && actualValue != nil
+		if actualKey != test[1] || actualValue != test[2] || actualFound != test[3] {
+			t.Errorf("Got %v, %v, %v, expected %v, %v, %v", actualKey, actualValue, actualFound, test[1], test[2], test[3])
+		}
+	}
+}
+
+func sameElements(a []interface{}, b []interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, av := range a {
+		found := false
+		for _, bv := range b {
+			if av == bv {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func TestMapEach(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	count := 0
+	m.Each(func(key interface{}, value interface{}) {
+		count++
+		if actualValue, expectedValue := count, value; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		switch value {
+		case 1:
+			if actualValue, expectedValue := key, "a"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 2:
+			if actualValue, expectedValue := key, "b"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 3:
+			if actualValue, expectedValue := key, "c"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+	})
+}
+
+func TestMapMap(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	mappedMap := m.Map(func(key1 interface{}, value1 interface{}) (key2 interface{}, value2 interface{}) {
+		return key1, value1.(int) * value1.(int)
+	})
+	if actualValue, _ := mappedMap.Get("a"); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, "mapped: a")
+	}
+	if actualValue, _ := mappedMap.Get("b"); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, "mapped: b")
+	}
+	if actualValue, _ := mappedMap.Get("c"); actualValue != 9 {
+		t.Errorf("Got %v expected %v", actualValue, "mapped: c")
+	}
+	if mappedMap.Size() != 3 {
+		t.Errorf("Got %v expected %v", mappedMap.Size(), 3)
+	}
+}
+
+func TestMapSelect(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	selectedMap := m.Select(func(key interface{}, value interface{}) bool {
+		return key.(string) >= "a" && key.(string) <= "b"
+	})
+	if actualValue, _ := selectedMap.Get("a"); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, "value: a")
+	}
+	if actualValue, _ := selectedMap.Get("b"); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, "value: b")
+	}
+	if selectedMap.Size() != 2 {
+		t.Errorf("Got %v expected %v", selectedMap.Size(), 2)
+	}
+}
+
+func TestMapAny(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	any := m.Any(func(key interface{}, value interface{}) bool {
+		return value.(int) == 3
+	})
+	if any != true {
+		t.Errorf("Got %v expected %v", any, true)
+	}
+	any = m.Any(func(key interface{}, value interface{}) bool {
+		return value.(int) == 4
+	})
+	if any != false {
+		t.Errorf("Got %v expected %v", any, false)
+	}
+}
+
+func TestMapAll(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	all := m.All(func(key interface{}, value interface{}) bool {
+		return key.(string) >= "a" && key.(string) <= "c"
+	})
+	if all != true {
+		t.Errorf("Got %v expected %v", all, true)
+	}
+	all = m.All(func(key interface{}, value interface{}) bool {
+		return key.(string) >= "a" && key.(string) <= "b"
+	})
+	if all != false {
+		t.Errorf("Got %v expected %v", all, false)
+	}
+}
+
+func TestMapFind(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	foundKey, foundValue := m.Find(func(key interface{}, value interface{}) bool {
+		return key.(string) == "c"
+	})
+	if foundKey != "c" || foundValue != 3 {
+		t.Errorf("Got %v -> %v expected %v -> %v", foundKey, foundValue, "c", 3)
+	}
+	foundKey, foundValue = m.Find(func(key interface{}, value interface{}) bool {
+		return key.(string) == "x"
+	})
+	if foundKey != nil || foundValue != nil {
+		t.Errorf("Got %v at %v expected %v at %v", foundValue, foundKey, nil, nil)
+	}
+}
+
+func TestMapFold(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	if actualValue, expectedValue := m.Fold(10, func(accumulator interface{}, key interface{}, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}), 20; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Reduce(func(accumulator interface{}, key interface{}, value interface{}) interface{} {
+		return accumulator.(int) * value.(int)
+	}), 24; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	empty := NewWithStringComparator()
+	if actualValue, expectedValue := empty.Fold(10, func(accumulator interface{}, key interface{}, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := empty.Reduce(func(accumulator interface{}, key interface{}, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestMapCount(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	if actualValue, expectedValue := m.Count(func(key interface{}, value interface{}) bool {
+		return value.(int)%2 == 0
+	}), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapGroupBy(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	groups := hashmap.New()
+	m.GroupBy(func(key interface{}, value interface{}) interface{} {
+		return value.(int)%2 == 0
+	}, groups)
+	if actualValue, expectedValue := groups.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tests := [][]interface{}{
+		{true, "[2 4]"},
+		{false, "[1 3]"},
+	}
+	for _, test := range tests {
+		group, _ := groups.Get(test[0])
+		if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(group.(*arraylist.List), utils.IntComparator)), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestMapPartition(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	selected, rest := m.Partition(func(key interface{}, value interface{}) bool {
+		return value.(int)%2 == 0
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(selected, utils.IntComparator)), "[2 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(rest, utils.IntComparator)), "[1 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapMinByMaxBy(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	if key, value := m.MinBy(utils.IntComparator); key != "a" || value != 1 {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, "a", 1)
+	}
+	if key, value := m.MaxBy(utils.IntComparator); key != "d" || value != 4 {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, "d", 4)
+	}
+	empty := NewWithStringComparator()
+	if key, value := empty.MinBy(utils.IntComparator); key != nil || value != nil {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, nil, nil)
+	}
+	if key, value := empty.MaxBy(utils.IntComparator); key != nil || value != nil {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, nil, nil)
+	}
+}
+
+func TestMapSum(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	if actualValue, expectedValue := m.SumInt(func(key interface{}, value interface{}) int {
+		return value.(int)
+	}), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.SumFloat64(func(key interface{}, value interface{}) float64 {
+		return float64(value.(int)) / 2
+	}), 5.0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapDistinct(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 1)
+	distinct := m.Distinct()
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(distinct, utils.IntComparator)), "[1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapChaining(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	chainedMap := m.Select(func(key interface{}, value interface{}) bool {
+		return value.(int) > 1
+	}).Map(func(key interface{}, value interface{}) (interface{}, interface{}) {
+		return key.(string) + key.(string), value.(int) * value.(int)
+	})
+	if actualValue := chainedMap.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, found := chainedMap.Get("aa"); actualValue != nil || found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, found := chainedMap.Get("bb"); actualValue != 4 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, found := chainedMap.Get("cc"); actualValue != 9 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 9)
+	}
+}
+
+func TestMapIteratorNextOnEmpty(t *testing.T) {
+	m := NewWithStringComparator()
+	it := m.Iterator()
+	it = m.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty map")
+	}
+}
+
+func TestMapIteratorPrevOnEmpty(t *testing.T) {
+	m := NewWithStringComparator()
+	it := m.Iterator()
+	it = m.Iterator()
+	for it.Prev() {
+		t.Errorf("Shouldn't iterate on empty map")
+	}
+}
+
+func TestMapIteratorNext(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	it := m.Iterator()
+	count := 0
+	for it.Next() {
+		count++
+		key := it.Key()
+		value := it.Value()
+		switch key {
+		case "a":
+			if actualValue, expectedValue := value, 1; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case "b":
+			if actualValue, expectedValue := value, 2; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case "c":
+			if actualValue, expectedValue := value, 3; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+		if actualValue, expectedValue := value, count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapIteratorPrev(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	it := m.Iterator()
+	for it.Next() {
+	}
+	countDown := m.Size()
+	for it.Prev() {
+		key := it.Key()
+		value := it.Value()
+		switch key {
+		case "a":
+			if actualValue, expectedValue := value, 1; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case "b":
+			if actualValue, expectedValue := value, 2; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case "c":
+			if actualValue, expectedValue := value, 3; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+		if actualValue, expectedValue := value, countDown; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		countDown--
+	}
+	if actualValue, expectedValue := countDown, 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapIteratorBegin(t *testing.T) {
+	m := NewWithIntComparator()
+	it := m.Iterator()
+	it.Begin()
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+	for it.Next() {
+	}
+	it.Begin()
+	it.Next()
+	if key, value := it.Key(), it.Value(); key != 1 || value != "a" {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, 1, "a")
+	}
+}
+
+func TestMapIteratorEnd(t *testing.T) {
+	m := NewWithIntComparator()
+	it := m.Iterator()
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+	it.End()
+	it.Prev()
+	if key, value := it.Key(), it.Value(); key != 3 || value != "c" {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, 3, "c")
+	}
+}
+
+func TestMapIteratorFirst(t *testing.T) {
+	m := NewWithIntComparator()
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+	it := m.Iterator()
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if key, value := it.Key(), it.Value(); key != 1 || value != "a" {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, 1, "a")
+	}
+}
+
+func TestMapIteratorLast(t *testing.T) {
+	m := NewWithIntComparator()
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+	it := m.Iterator()
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if key, value := it.Key(), it.Value(); key != 3 || value != "c" {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, 3, "c")
+	}
+}
+
+func TestMapIteratorNextTo(t *testing.T) {
+	// Sample seek function, i.e. string starting with "b"
+	seek := func(index interface{}, value interface{}) bool {
+		return strings.HasSuffix(value.(string), "b")
+	}
+
+	// NextTo (empty)
+	{
+		m := NewWithIntComparator()
+		it := m.Iterator()
+		for it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty map")
+		}
+	}
+
+	// NextTo (not found)
+	{
+		m := NewWithIntComparator()
+		m.Put(0, "xx")
+		m.Put(1, "yy")
+		it := m.Iterator()
+		for it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty map")
+		}
+	}
+
+	// NextTo (found)
+	{
+		m := NewWithIntComparator()
+		m.Put(0, "aa")
+		m.Put(1, "bb")
+		m.Put(2, "cc")
+		it := m.Iterator()
+		it.Begin()
+		if !it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty map")
+		}
+		if index, value := it.Key(), it.Value(); index != 1 || value.(string) != "bb" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 1, "bb")
+		}
+		if !it.Next() {
+			t.Errorf("Should go to first element")
+		}
+		if index, value := it.Key(), it.Value(); index != 2 || value.(string) != "cc" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 2, "cc")
+		}
+		if it.Next() {
+			t.Errorf("Should not go past last element")
+		}
+	}
+}
+
+func TestMapIteratorPrevTo(t *testing.T) {
+	// Sample seek function, i.e. string starting with "b"
+	seek := func(index interface{}, value interface{}) bool {
+		return strings.HasSuffix(value.(string), "b")
+	}
+
+	// PrevTo (empty)
+	{
+		m := NewWithIntComparator()
+		it := m.Iterator()
+		it.End()
+		for it.PrevTo(seek) {
+			t.Errorf("Shouldn't iterate on empty map")
+		}
+	}
+
+	// PrevTo (not found)
+	{
+		m := NewWithIntComparator()
+		m.Put(0, "xx")
+		m.Put(1, "yy")
+		it := m.Iterator()
+		it.End()
+		for it.PrevTo(seek) {
+			t.Errorf("Shouldn't iterate on empty map")
+		}
+	}
+
+	// PrevTo (found)
+	{
+		m := NewWithIntComparator()
+		m.Put(0, "aa")
+		m.Put(1, "bb")
+		m.Put(2, "cc")
+		it := m.Iterator()
+		it.End()
+		if !it.PrevTo(seek) {
+			t.Errorf("Shouldn't iterate on empty map")
+		}
+		if index, value := it.Key(), it.Value(); index != 1 || value.(string) != "bb" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 1, "bb")
+		}
+		if !it.Prev() {
+			t.Errorf("Should go to first element")
+		}
+		if index, value := it.Key(), it.Value(); index != 0 || value.(string) != "aa" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 0, "aa")
+		}
+		if it.Prev() {
+			t.Errorf("Should not go before first element")
+		}
+	}
+}
+
+func TestMapSerialization(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		original := NewWithStringComparator()
+		original.Put("d", "4")
+		original.Put("e", "5")
+		original.Put("c", "3")
+		original.Put("b", "2")
+		original.Put("a", "1")
+
+		assertSerialization(original, "A", t)
+
+		serialized, err := original.ToJSON()
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+		assertSerialization(original, "B", t)
+
+		deserialized := NewWithStringComparator()
+		err = deserialized.FromJSON(serialized)
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+		assertSerialization(deserialized, "C", t)
+	}
+
+	m := NewWithStringComparator()
+	m.Put("a", 1.0)
+	m.Put("b", 2.0)
+	m.Put("c", 3.0)
+
+	_, err := json.Marshal([]interface{}{"a", "b", "c", m})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"a":1,"b":2}`), &m)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+}
+
+func TestMapString(t *testing.T) {
+	c := NewWithStringComparator()
+	c.Put("a", 1)
+	if !strings.HasPrefix(c.String(), "SkipListMap") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+// noinspection GoBoolExpressions
+func assertSerialization(m *Map, txt string, t *testing.T) {
+	if actualValue := m.Keys(); false ||
+		actualValue[0].(string) != "a" ||
+		actualValue[1].(string) != "b" ||
+		actualValue[2].(string) != "c" ||
+		actualValue[3].(string) != "d" ||
+		actualValue[4].(string) != "e" {
+		t.Errorf("[%s] Got %v expected %v", txt, actualValue, "[a,b,c,d,e]")
+	}
+	if actualValue := m.Values(); false ||
+		actualValue[0].(string) != "1" ||
+		actualValue[1].(string) != "2" ||
+		actualValue[2].(string) != "3" ||
+		actualValue[3].(string) != "4" ||
+		actualValue[4].(string) != "5" {
+		t.Errorf("[%s] Got %v expected %v", txt, actualValue, "[1,2,3,4,5]")
+	}
+	if actualValue, expectedValue := m.Size(), 5; actualValue != expectedValue {
+		t.Errorf("[%s] Got %v expected %v", txt, actualValue, expectedValue)
+	}
+}
+
+func TestMapIteratorConcurrentModification(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+
+	// modifications before the first element are allowed
+	it := m.Iterator()
+	m.Put("d", 4)
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, m.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// modifications after Begin() or End() are allowed, moving in either direction accepts them
+	it.End()
+	m.Put("f", 6)
+	if it.Next() {
+		t.Errorf("Should not iterate past the last element")
+	}
+	if !it.Prev() || it.Key() != "f" {
+		t.Errorf("Got %v expected %v", it.Key(), "f")
+	}
+	it.Begin()
+	m.Remove("f")
+	if it.Prev() {
+		t.Errorf("Should not iterate before the first element")
+	}
+	if !it.Next() || it.Key() != "a" {
+		t.Errorf("Got %v expected %v", it.Key(), "a")
+	}
+
+	// modifications during iteration panic
+	func() {
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		it := m.Iterator()
+		it.Next()
+		m.Remove("a")
+		it.Next()
+	}()
+
+	// checked iterator stops and reports the error
+	checked := m.CheckedIterator()
+	checked.Next()
+	m.Put("e", 5)
+	if checked.Next() {
+		t.Errorf("Should not iterate after modification")
+	}
+	if actualValue, expectedValue := checked.Err(), containers.ErrConcurrentModification; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checked.Begin()
+	if actualValue := checked.Err(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	count = 0
+	for checked.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, m.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapIteratorModifiedAtBoundaries(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("b", 0)
+	m.Put("c", 0)
+	it := m.Iterator()
+	it.Next()
+
+	// moving from Begin() or End() after a modification starts over on the modified container
+	tests := [][]interface{}{
+		{true, true, "a", "a"},
+		{false, true, "d", "d"},
+		{true, false, "a", "b"},
+		{false, false, "d", "c"},
+	}
+	for _, test := range tests {
+		forward, put, key, expectedValue := test[0].(bool), test[1].(bool), test[2], test[3]
+		if forward {
+			it.Begin()
+		} else {
+			it.End()
+		}
+		if put {
+			m.Put(key, 0)
+		} else {
+			m.Remove(key)
+		}
+		moved := false
+		if forward {
+			moved = it.Next()
+		} else {
+			moved = it.Prev()
+		}
+		if !moved {
+			t.Errorf("Got %v expected %v", moved, true)
+			continue
+		}
+		if actualValue := it.Key(); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestMapIteratorClone(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+
+	it := m.Iterator()
+	it.Next()
+	clone := it.Clone()
+	first := it.Key()
+	it.Next()
+	if actualValue, expectedValue := clone.Key(), first; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !clone.Next() || clone.Key() != it.Key() {
+		t.Errorf("Got %v expected %v", clone.Key(), it.Key())
+	}
+	clone.Next()
+	if clone.Next() {
+		t.Errorf("Should not go past the last element")
+	}
+	if !it.Next() {
+		t.Errorf("Should move independently of the clone")
+	}
+}
+
+func TestMapIteratorSeek(t *testing.T) {
+	m := NewWithIntComparator()
+	for i := 2; i <= 20; i += 2 {
+		m.Put(i, i)
+	}
+	it := m.Iterator()
+	tests := [][]interface{}{
+		{it.SeekGE, 4, true, 4},
+		{it.SeekGE, 5, true, 6},
+		{it.SeekGE, 0, true, 2},
+		{it.SeekGE, 21, false, nil},
+		{it.SeekGT, 4, true, 6},
+		{it.SeekGT, 20, false, nil},
+		{it.SeekLE, 4, true, 4},
+		{it.SeekLE, 5, true, 4},
+		{it.SeekLE, 1, false, nil},
+		{it.SeekLT, 4, true, 2},
+		{it.SeekLT, 100, true, 20},
+		{it.SeekLT, 2, false, nil},
+	}
+	for _, test := range tests {
+		found := test[0].(func(interface{}) bool)(test[1])
+		if actualValue, expectedValue := found, test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if found && it.Key() != test[3] {
+			t.Errorf("Got %v expected %v", it.Key(), test[3])
+		}
+	}
+
+	// iteration continues from the sought element in both directions
+	it.SeekGE(5)
+	if !it.Next() || it.Key() != 8 {
+		t.Errorf("Got %v expected %v", it.Key(), 8)
+	}
+	it.SeekGE(5)
+	if !it.Prev() || it.Key() != 4 {
+		t.Errorf("Got %v expected %v", it.Key(), 4)
+	}
+	it.SeekGT(20)
+	if !it.Prev() || it.Key() != 20 {
+		t.Errorf("Got %v expected %v", it.Key(), 20)
+	}
+	it.SeekLT(2)
+	if !it.Next() || it.Key() != 2 {
+		t.Errorf("Got %v expected %v", it.Key(), 2)
+	}
+}
+
+func TestMapSeq(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	pairs := []string{}
+	for key, value := range m.AllSeq() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "a:1 b:2 c:3"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(m.KeysSeq())), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(m.ValuesSeq())), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	pairs = []string{}
+	for key, value := range m.Backward() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "c:3 b:2 a:1"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	count := 0
+	for key := range m.KeysSeq() {
+		if key == "b" {
+			break
+		}
+		count++
+	}
+	if actualValue, expectedValue := count, 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapSeed(t *testing.T) {
+	levels := func(m *Map) []int {
+		levels := []int{}
+		for node := m.head.next[0]; node != nil; node = node.next[0] {
+			levels = append(levels, len(node.next))
+		}
+		return levels
+	}
+	m1, m2, m3 := NewWithSeed(utils.IntComparator, 1), NewWithSeed(utils.IntComparator, 1), NewWithSeed(utils.IntComparator, 2)
+	for key := 0; key < 100; key++ {
+		m1.Put(key, key)
+		m2.Put(key, key)
+		m3.Put(key, key)
+	}
+	if actualValue, expectedValue := levels(m1), levels(m2); !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := levels(m1), levels(m3); slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected levels different from %v", actualValue, expectedValue)
+	}
+	assertSkipList(t, m1)
+	assertSkipList(t, m3)
+}
+
+func TestMapRandom(t *testing.T) {
+	random := rand.New(rand.NewSource(3))
+	for seed := int64(0); seed < 5; seed++ {
+		m, expected := randomMap(random, seed)
+		assertSkipList(t, m)
+		if actualValue, expectedValue := m.Size(), len(expected); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		for key := -1; key <= 300; key++ {
+			assertMapLookups(t, m, expected, key)
+		}
+	}
+}
+
+// randomMap returns a map of the seed built by random puts and removes along with the expected entries.
+func randomMap(random *rand.Rand, seed int64) (*Map, map[int]int) {
+	m := NewWithSeed(utils.IntComparator, seed)
+	expected := map[int]int{}
+	for i := 0; i < 2000; i++ {
+		key := random.Intn(300)
+		if random.Intn(3) == 0 {
+			m.Remove(key)
+			delete(expected, key)
+		} else {
+			m.Put(key, i)
+			expected[key] = i
+		}
+	}
+	return m, expected
+}
+
+// expectedFloorAndCeiling returns the closest expected keys below and above the key, -1 and 300 if there are none.
+func expectedFloorAndCeiling(expected map[int]int, key int) (int, int) {
+	floor, ceiling := key, key
+	for _, found := expected[floor]; !found && floor >= 0; _, found = expected[floor] {
+		floor--
+	}
+	for _, found := expected[ceiling]; !found && ceiling < 300; _, found = expected[ceiling] {
+		ceiling++
+	}
+	return floor, ceiling
+}
+
+// assertMapLookups checks Get, Floor and Ceiling of the key against the expected entries with keys in [0, 300).
+func assertMapLookups(t *testing.T, m *Map, expected map[int]int, key int) {
+	t.Helper()
+	value, found := m.Get(key)
+	if expectedValue, expectedFound := expected[key]; found != expectedFound || found && value != expectedValue {
+		t.Errorf("Got %v expected %v", value, expectedValue)
+	}
+	floor, ceiling := expectedFloorAndCeiling(expected, key)
+	if actualValue, _ := m.Floor(key); floor >= 0 && actualValue != floor || floor < 0 && actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, floor)
+	}
+	if actualValue, _ := m.Ceiling(key); ceiling < 300 && actualValue != ceiling || ceiling >= 300 && actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, ceiling)
+	}
+}
+
+// assertSkipList checks that the keys are in order on every level, that every level is a subsequence of the level
+// below it, that the backward links mirror the lowest level and that the size, level and tail are consistent.
+func assertSkipList(t *testing.T, m *Map) {
+	t.Helper()
+	for level := 0; level < maxLevel; level++ {
+		if actualValue, expectedValue := m.head.next[level] != nil, level < m.level; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		below := m.head
+		for node := m.head.next[level]; node != nil; node = node.next[level] {
+			if len(node.next) <= level {
+				t.Fatalf("Got %v expected more than %v levels", len(node.next), level)
+			}
+			if level > 0 {
+				for below != nil && below != node {
+					below = below.next[level-1]
+				}
+				if below == nil {
+					t.Fatalf("Got %v not on level %v", node.key, level-1)
+				}
+			}
+			if next := node.next[level]; next != nil && m.Comparator(node.key, next.key) >= 0 {
+				t.Errorf("Got %v before %v", node.key, next.key)
+			}
+		}
+	}
+	size := 0
+	var prev *node
+	for node := m.head.next[0]; node != nil; node = node.next[0] {
+		if node.prev != prev {
+			t.Errorf("Got %v expected %v", node.prev, prev)
+		}
+		prev = node
+		size++
+	}
+	if m.tail != prev {
+		t.Errorf("Got %v expected %v", m.tail, prev)
+	}
+	if actualValue, expectedValue := m.Size(), size; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func benchmarkGet(b *testing.B, m *Map, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Get(n)
+		}
+	}
+}
+
+func benchmarkPut(b *testing.B, m *Map, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Put(n, struct{}{})
+		}
+	}
+}
+
+func benchmarkRemove(b *testing.B, m *Map, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Remove(n)
+		}
+	}
+}
+
+func BenchmarkSkipListMapGet100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkSkipListMapGet1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	m := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkSkipListMapGet10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkSkipListMapGet100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	m := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkSkipListMapPut100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := NewWithIntComparator()
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkSkipListMapPut1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	m := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkSkipListMapPut10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkSkipListMapPut100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	m := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkSkipListMapRemove100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
+
+func BenchmarkSkipListMapRemove1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	m := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
+
+func BenchmarkSkipListMapRemove10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
+
+func BenchmarkSkipListMapRemove100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	m := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
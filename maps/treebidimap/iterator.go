@@ -5,6 +5,8 @@
 package treebidimap
 
 import (
+	"iter"
+
 	"github.com/uncle-gua/gods/containers"
 	rbt "github.com/uncle-gua/gods/trees/redblacktree"
 )
@@ -18,10 +20,19 @@ type Iterator struct {
 }
 
 // Iterator returns a stateful iterator whose elements are key/value pairs.
+// The iterator fails fast: moving it after the map was structurally modified other than through the iterator
+// panics with containers.ErrConcurrentModification. Modifications made while the iterator is before the first
+// or past the last element, e.g. after Begin() or End(), are allowed.
 func (m *Map) Iterator() Iterator {
 	return Iterator{iterator: m.forwardMap.Iterator()}
 }
 
+// CheckedIterator returns a stateful iterator like Iterator(), except that instead of panicking when the map was
+// modified during iteration, it stops and reports containers.ErrConcurrentModification by Err().
+func (m *Map) CheckedIterator() Iterator {
+	return Iterator{iterator: m.forwardMap.CheckedIterator()}
+}
+
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
 // If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
@@ -49,6 +60,13 @@ func (iterator *Iterator) Key() interface{} {
 	return iterator.iterator.Key()
 }
 
+// Clone returns an independent copy of the iterator at the same position, e.g. for lookahead or backtracking.
+// Moving either of the iterators does not move the other one.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Clone() Iterator {
+	return *iterator
+}
+
 // Begin resets the iterator to its initial state (one-before-first)
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator) Begin() {
@@ -102,3 +120,61 @@ func (iterator *Iterator) PrevTo(f func(key interface{}, value interface{}) bool
 	}
 	return false
 }
+
+// Err returns containers.ErrConcurrentModification if the checked iterator stopped because the map was modified
+// during iteration, otherwise nil.
+func (iterator *Iterator) Err() error {
+	return iterator.iterator.Err()
+}
+
+// AllSeq returns an iterator over key-value pairs of the map in order, for use with range-over-func.
+// It is not named All, which is the predicate of Enumerable.
+func (m *Map) AllSeq() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		iterator := m.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Key(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns an iterator over keys of the map in order, for use with range-over-func.
+// It is not named Keys, which returns the keys as a slice.
+func (m *Map) KeysSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		iterator := m.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Key()) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over values of the map in order of their keys, for use with range-over-func.
+// It is not named Values, which returns the values as a slice.
+func (m *Map) ValuesSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		iterator := m.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over key-value pairs of the map in reverse order, for use with range-over-func.
+func (m *Map) Backward() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		iterator := m.Iterator()
+		iterator.End()
+		for iterator.Prev() {
+			if !yield(iterator.Key(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
@@ -7,7 +7,11 @@ package treebidimap
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/lists/arraylist"
+	"github.com/uncle-gua/gods/maps/hashmap"
 	"github.com/uncle-gua/gods/utils"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -300,6 +304,140 @@ func TestMapFind(t *testing.T) {
 	}
 }
 
+func TestMapFold(t *testing.T) {
+	m := NewWith(utils.StringComparator, utils.IntComparator)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	if actualValue, expectedValue := m.Fold(10, func(accumulator interface{}, key interface{}, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}), 20; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Reduce(func(accumulator interface{}, key interface{}, value interface{}) interface{} {
+		return accumulator.(int) * value.(int)
+	}), 24; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	empty := NewWith(utils.StringComparator, utils.IntComparator)
+	if actualValue, expectedValue := empty.Fold(10, func(accumulator interface{}, key interface{}, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := empty.Reduce(func(accumulator interface{}, key interface{}, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestMapCount(t *testing.T) {
+	m := NewWith(utils.StringComparator, utils.IntComparator)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	if actualValue, expectedValue := m.Count(func(key interface{}, value interface{}) bool {
+		return value.(int)%2 == 0
+	}), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapGroupBy(t *testing.T) {
+	m := NewWith(utils.StringComparator, utils.IntComparator)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	groups := hashmap.New()
+	m.GroupBy(func(key interface{}, value interface{}) interface{} {
+		return value.(int)%2 == 0
+	}, groups)
+	if actualValue, expectedValue := groups.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tests := [][]interface{}{
+		{true, "[2 4]"},
+		{false, "[1 3]"},
+	}
+	for _, test := range tests {
+		group, _ := groups.Get(test[0])
+		if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(group.(*arraylist.List), utils.IntComparator)), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestMapPartition(t *testing.T) {
+	m := NewWith(utils.StringComparator, utils.IntComparator)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	selected, rest := m.Partition(func(key interface{}, value interface{}) bool {
+		return value.(int)%2 == 0
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(selected, utils.IntComparator)), "[2 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(rest, utils.IntComparator)), "[1 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapMinByMaxBy(t *testing.T) {
+	m := NewWith(utils.StringComparator, utils.IntComparator)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	if key, value := m.MinBy(utils.IntComparator); key != "a" || value != 1 {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, "a", 1)
+	}
+	if key, value := m.MaxBy(utils.IntComparator); key != "d" || value != 4 {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, "d", 4)
+	}
+	empty := NewWith(utils.StringComparator, utils.IntComparator)
+	if key, value := empty.MinBy(utils.IntComparator); key != nil || value != nil {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, nil, nil)
+	}
+	if key, value := empty.MaxBy(utils.IntComparator); key != nil || value != nil {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, nil, nil)
+	}
+}
+
+func TestMapSum(t *testing.T) {
+	m := NewWith(utils.StringComparator, utils.IntComparator)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	if actualValue, expectedValue := m.SumInt(func(key interface{}, value interface{}) int {
+		return value.(int)
+	}), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.SumFloat64(func(key interface{}, value interface{}) float64 {
+		return float64(value.(int)) / 2
+	}), 5.0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapDistinct(t *testing.T) {
+	m := NewWith(utils.StringComparator, utils.IntComparator)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 1)
+	distinct := m.Distinct()
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(distinct, utils.IntComparator)), "[1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestMapChaining(t *testing.T) {
 	m := NewWith(utils.StringComparator, utils.IntComparator)
 	m.Put("c", 3)
@@ -653,6 +791,125 @@ func assertSerialization(m *Map, txt string, t *testing.T) {
 	}
 }
 
+func TestMapIteratorConcurrentModification(t *testing.T) {
+	m := NewWith(utils.StringComparator, utils.IntComparator)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+
+	// modifications before the first element are allowed
+	it := m.Iterator()
+	m.Put("d", 4)
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, m.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// modifications during iteration panic
+	func() {
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		it := m.Iterator()
+		it.Next()
+		m.Remove("a")
+		it.Next()
+	}()
+
+	// checked iterator stops and reports the error
+	checked := m.CheckedIterator()
+	checked.Next()
+	m.Put("e", 5)
+	if checked.Next() {
+		t.Errorf("Should not iterate after modification")
+	}
+	if actualValue, expectedValue := checked.Err(), containers.ErrConcurrentModification; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checked.Begin()
+	if actualValue := checked.Err(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	count = 0
+	for checked.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, m.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapIteratorClone(t *testing.T) {
+	m := NewWith(utils.StringComparator, utils.IntComparator)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+
+	it := m.Iterator()
+	it.Next()
+	clone := it.Clone()
+	first := it.Key()
+	it.Next()
+	if actualValue, expectedValue := clone.Key(), first; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !clone.Next() || clone.Key() != it.Key() {
+		t.Errorf("Got %v expected %v", clone.Key(), it.Key())
+	}
+	clone.Next()
+	if clone.Next() {
+		t.Errorf("Should not go past the last element")
+	}
+	if !it.Next() {
+		t.Errorf("Should move independently of the clone")
+	}
+}
+
+func TestMapSeq(t *testing.T) {
+	m := NewWith(utils.StringComparator, utils.IntComparator)
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	pairs := []string{}
+	for key, value := range m.AllSeq() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "a:1 b:2 c:3"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(m.KeysSeq())), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(m.ValuesSeq())), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	pairs = []string{}
+	for key, value := range m.Backward() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "c:3 b:2 a:1"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	count := 0
+	for key := range m.KeysSeq() {
+		if key == "b" {
+			break
+		}
+		count++
+	}
+	if actualValue, expectedValue := count, 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func benchmarkGet(b *testing.B, m *Map, size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -16,6 +16,8 @@ import (
 	"github.com/uncle-gua/gods/maps"
 	rbt "github.com/uncle-gua/gods/trees/redblacktree"
 	"github.com/uncle-gua/gods/utils"
+	"math/bits"
+	"reflect"
 	"strings"
 )
 
@@ -138,6 +140,91 @@ func (m *Map) Ceiling(key interface{}) (foundKey interface{}, foundValue interfa
 	return nil, nil
 }
 
+// Rank returns the number of keys in the map that are smaller than the given key in O(log n) time,
+// i.e. the index the key has or would have if it were put into the map.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Rank(key interface{}) int {
+	return m.tree.Rank(key)
+}
+
+// IndexOf returns the index of the key in the sorted sequence of keys in O(log n) time,
+// or -1 if key is not found in map.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) IndexOf(key interface{}) int {
+	return m.tree.IndexOf(key)
+}
+
+// GetAt returns the key-value pair at the given index in the sorted sequence of keys in O(log n) time.
+// Third return parameter is true if index is within bounds, otherwise false.
+func (m *Map) GetAt(index int) (key interface{}, value interface{}, found bool) {
+	if node, found := m.tree.GetAt(index); found {
+		return node.Key, node.Value, true
+	}
+	return nil, nil, false
+}
+
+// CountRange returns the number of keys in the half-open range [from, to) in O(log n) time.
+// Keys should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) CountRange(from, to interface{}) int {
+	return m.tree.CountRange(from, to)
+}
+
+// Split moves the elements with keys smaller than the key into the left map and all other elements into the right map
+// in O(log n) time, leaving the map empty.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Split(key interface{}) (left *Map, right *Map) {
+	leftTree, rightTree := m.tree.Split(key)
+	return &Map{tree: leftTree}, &Map{tree: rightTree}
+}
+
+// Join moves the elements of the left and the right map, whose keys must all be smaller in the left map than in the
+// right map, into a new map with the left map's comparator in O(log n) time, leaving both maps empty.
+// Returns an error wrapping trees.ErrUnsorted if the keys are not in order, in which case the maps are not modified.
+func Join(left *Map, right *Map) (*Map, error) {
+	tree, err := rbt.Join(left.tree, right.tree)
+	if err != nil {
+		return nil, err
+	}
+	return &Map{tree: tree}, nil
+}
+
+// Union returns the union of two maps in O(n+m) time, i.e. linear in the size of the new map.
+// The new map consists of all elements that are in "m" or "another" (possibly both),
+// with the values in "m" for keys in both maps.
+// The two maps should have the same comparators, otherwise the result is empty map.
+func (m *Map) Union(another *Map) *Map {
+	if !m.sameComparator(another) {
+		return NewWith(m.tree.Comparator)
+	}
+	return &Map{tree: rbt.Union(m.copyTree(), another.copyTree())}
+}
+
+// Intersection returns the intersection of two maps.
+// The new map consists of all elements of "m" whose keys are also in "another".
+// It takes O(k*log(n)) time if the smaller map of size k is much smaller than the larger map of size n,
+// otherwise O(n+k) time.
+// The two maps should have the same comparators, otherwise the result is empty map.
+func (m *Map) Intersection(another *Map) *Map {
+	if !m.sameComparator(another) {
+		return NewWith(m.tree.Comparator)
+	}
+	smaller, larger := m, another
+	if smaller.Size() > larger.Size() {
+		smaller, larger = larger, smaller
+	}
+	if smaller.Size()*bits.Len(uint(larger.Size())) < larger.Size() {
+		// Iterate over the much smaller map (optimization)
+		result := NewWith(m.tree.Comparator)
+		for it := smaller.Iterator(); it.Next(); {
+			if node := m.tree.GetNode(it.Key()); node != nil && another.tree.GetNode(it.Key()) != nil {
+				result.Put(node.Key, node.Value)
+			}
+		}
+		return result
+	}
+	return &Map{tree: rbt.Intersection(m.copyTree(), another.copyTree())}
+}
+
 // String returns a string representation of container
 func (m *Map) String() string {
 	str := "TreeMap\nmap["
@@ -148,3 +235,13 @@ func (m *Map) String() string {
 	return strings.TrimRight(str, " ") + "]"
 
 }
+
+func (m *Map) sameComparator(another *Map) bool {
+	return reflect.ValueOf(m.tree.Comparator).Pointer() == reflect.ValueOf(another.tree.Comparator).Pointer()
+}
+
+// copyTree returns a copy of the map's tree in O(n) time.
+func (m *Map) copyTree() *rbt.Tree {
+	tree, _ := rbt.NewFromSorted(m.tree.Comparator, m.tree.Keys(), m.tree.Values()) // the keys are in order
+	return tree
+}
@@ -6,8 +6,16 @@ package treemap
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/lists/arraylist"
+	"github.com/uncle-gua/gods/maps/hashmap"
+	"github.com/uncle-gua/gods/trees"
 	"github.com/uncle-gua/gods/utils"
+	"math"
+	"reflect"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -54,6 +62,84 @@ func TestMapPut(t *testing.T) {
 	}
 }
 
+func TestMapPutWithStringComparators(t *testing.T) {
+	m := NewWith(utils.NaturalStringComparator)
+	m.Put("file10", 10)
+	m.Put("file2", 2)
+	m.Put("file1", 1)
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[file1 file2 file10]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	m = NewWith(utils.CaseInsensitiveStringComparator)
+	m.Put("Apple", 1)
+	m.Put("banana", 2)
+	m.Put("apple", 3) //overwrite
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[apple banana]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := m.Get("APPLE"); actualValue != 3 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+
+	m = NewWith(utils.NormalizedStringComparator)
+	m.Put("caf\u00e9", 1)
+	m.Put("cafe\u0301", 2) //overwrite
+	if actualValue := m.Size(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, found := m.Get("caf\u00e9"); actualValue != 2 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
+
+func TestMapPutWithFloatKeys(t *testing.T) {
+	m := NewWith(utils.Float64TotalComparator)
+	m.Put(math.NaN(), "nan")
+	m.Put(1.0, "one")
+	m.Put(math.Copysign(0, -1), "-zero")
+	m.Put(0.0, "zero")
+	m.Put(math.NaN(), "NaN") //overwrite
+	m.Put(math.Inf(-1), "-inf")
+
+	if actualValue := m.Size(); actualValue != 5 {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Values()), "[-inf -zero zero one NaN]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := m.Get(math.NaN()); actualValue != "NaN" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "NaN")
+	}
+	m.Remove(math.NaN())
+	if actualValue, found := m.Get(math.NaN()); actualValue != nil || found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestMapPutWithReflectComparator(t *testing.T) {
+	type key struct {
+		tenant string
+		ts     int64
+	}
+	comparator, err := utils.ReflectComparator(reflect.TypeOf(key{}))
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	m := NewWith(comparator)
+	m.Put(key{"b", 1}, "b1")
+	m.Put(key{"a", 2}, "a2")
+	m.Put(key{"a", 1}, "a1")
+	m.Put(key{"b", 1}, "B1") //overwrite
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Values()), "[a1 a2 B1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := m.Get(key{"a", 2}); actualValue != "a2" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a2")
+	}
+}
+
 func TestMapMin(t *testing.T) {
 	m := NewWithIntComparator()
 
@@ -244,6 +330,181 @@ func TestMapCeiling(t *testing.T) {
 	}
 }
 
+func TestMapOrderStatistics(t *testing.T) {
+	m := NewWithIntComparator()
+	for _, key := range []int{12, 4, 20, 8, 16, 2, 10, 18, 6, 14} {
+		m.Put(key, key)
+	}
+	m.Remove(10)
+
+	tests1 := [][]interface{}{
+		{1, 0, -1},
+		{2, 0, 0},
+		{3, 1, -1},
+		{10, 4, -1},
+		{12, 4, 4},
+		{20, 8, 8},
+		{21, 9, -1},
+	}
+	for _, test := range tests1 {
+		if actualValue, expectedValue := m.Rank(test[0]), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := m.IndexOf(test[0]), test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	tests2 := [][]interface{}{
+		{-1, nil, false},
+		{0, 2, true},
+		{4, 12, true},
+		{8, 20, true},
+		{9, nil, false},
+	}
+	for _, test := range tests2 {
+		key, _, found := m.GetAt(test[0].(int))
+		if actualValue, expectedValue := found, test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := key, test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	tests3 := [][]interface{}{
+		{0, 100, 9},
+		{4, 14, 4},
+		{13, 15, 1},
+		{5, 5, 0},
+		{14, 4, 0},
+	}
+	for _, test := range tests3 {
+		if actualValue, expectedValue := m.CountRange(test[0], test[1]), test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestMapOrderStatisticsConsistency(t *testing.T) {
+	m := NewWithIntComparator()
+	for key := 0; key < 1000; key++ {
+		m.Put(key, key)
+	}
+	for key := 0; key < 1000; key += 3 {
+		m.Remove(key)
+	}
+	for index := 0; index < 666; index++ {
+		key, _, found := m.GetAt(index)
+		if !found || m.IndexOf(key) != index || m.Rank(key) != index {
+			t.Errorf("Got %v expected %v", m.IndexOf(key), index)
+		}
+	}
+}
+
+func TestMapSplitAndJoin(t *testing.T) {
+	m := NewWithIntComparator()
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(4, "d")
+	m.Put(1, "a")
+	m.Put(2, "b")
+
+	left, right := m.Split(4)
+	if actualValue, expectedValue := fmt.Sprintf("%v", left.Keys()), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", right.Values()), "[d e f g]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if _, err := Join(right, left); !errors.Is(err, trees.ErrUnsorted) {
+		t.Errorf("Got %v expected %v", err, trees.ErrUnsorted)
+	}
+	m, err := Join(left, right)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[1 2 3 4 5 6 7]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := left.Size()+right.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Put(8, "h")
+	if actualValue, expectedValue := m.IndexOf(8), 7; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapUnionAndIntersection(t *testing.T) {
+	m := NewWithIntComparator()
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+	another := NewWithIntComparator()
+	another.Put(2, "x")
+	another.Put(3, "y")
+	another.Put(4, "z")
+
+	union := m.Union(another)
+	if actualValue, expectedValue := fmt.Sprintf("%v", union.Keys()), "[1 2 3 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", union.Values()), "[a b c z]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	intersection := m.Intersection(another)
+	if actualValue, expectedValue := fmt.Sprintf("%v", intersection.Keys()), "[2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", intersection.Values()), "[b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Size()+another.Size(), 6; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	other := NewWithStringComparator()
+	other.Put("a", 1)
+	if actualValue, expectedValue := m.Union(other).Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Intersection(other).Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapIntersectionLopsided(t *testing.T) {
+	small := NewWithIntComparator()
+	small.Put(5, "a")
+	small.Put(500, "b")
+	small.Put(2000, "c")
+	large := NewWithIntComparator()
+	for i := 0; i < 1000; i++ {
+		large.Put(i, fmt.Sprintf("v%d", i))
+	}
+	// the keys of the small map are looked up in the large map, the values are the receiver's
+	tests := [][]interface{}{
+		{small.Intersection(large), "[a b]"},
+		{large.Intersection(small), "[v5 v500]"},
+	}
+	for _, test := range tests {
+		intersection := test[0].(*Map)
+		if actualValue, expectedValue := fmt.Sprintf("%v", intersection.Keys()), "[5 500]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", intersection.Values()), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
 func sameElements(a []interface{}, b []interface{}) bool {
 	if len(a) != len(b) {
 		return false
@@ -391,6 +652,140 @@ func TestMapFind(t *testing.T) {
 	}
 }
 
+func TestMapFold(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	if actualValue, expectedValue := m.Fold(10, func(accumulator interface{}, key interface{}, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}), 20; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Reduce(func(accumulator interface{}, key interface{}, value interface{}) interface{} {
+		return accumulator.(int) * value.(int)
+	}), 24; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	empty := NewWithStringComparator()
+	if actualValue, expectedValue := empty.Fold(10, func(accumulator interface{}, key interface{}, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := empty.Reduce(func(accumulator interface{}, key interface{}, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestMapCount(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	if actualValue, expectedValue := m.Count(func(key interface{}, value interface{}) bool {
+		return value.(int)%2 == 0
+	}), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapGroupBy(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	groups := hashmap.New()
+	m.GroupBy(func(key interface{}, value interface{}) interface{} {
+		return value.(int)%2 == 0
+	}, groups)
+	if actualValue, expectedValue := groups.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tests := [][]interface{}{
+		{true, "[2 4]"},
+		{false, "[1 3]"},
+	}
+	for _, test := range tests {
+		group, _ := groups.Get(test[0])
+		if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(group.(*arraylist.List), utils.IntComparator)), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestMapPartition(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	selected, rest := m.Partition(func(key interface{}, value interface{}) bool {
+		return value.(int)%2 == 0
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(selected, utils.IntComparator)), "[2 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(rest, utils.IntComparator)), "[1 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapMinByMaxBy(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	if key, value := m.MinBy(utils.IntComparator); key != "a" || value != 1 {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, "a", 1)
+	}
+	if key, value := m.MaxBy(utils.IntComparator); key != "d" || value != 4 {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, "d", 4)
+	}
+	empty := NewWithStringComparator()
+	if key, value := empty.MinBy(utils.IntComparator); key != nil || value != nil {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, nil, nil)
+	}
+	if key, value := empty.MaxBy(utils.IntComparator); key != nil || value != nil {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, nil, nil)
+	}
+}
+
+func TestMapSum(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	if actualValue, expectedValue := m.SumInt(func(key interface{}, value interface{}) int {
+		return value.(int)
+	}), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.SumFloat64(func(key interface{}, value interface{}) float64 {
+		return float64(value.(int)) / 2
+	}), 5.0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapDistinct(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 1)
+	distinct := m.Distinct()
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(distinct, utils.IntComparator)), "[1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestMapChaining(t *testing.T) {
 	m := NewWithStringComparator()
 	m.Put("c", 3)
@@ -744,6 +1139,278 @@ func assertSerialization(m *Map, txt string, t *testing.T) {
 	}
 }
 
+func TestMapIteratorConcurrentModification(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+
+	// modifications before the first element are allowed
+	it := m.Iterator()
+	m.Put("d", 4)
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, m.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// modifications during iteration panic
+	func() {
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		it := m.Iterator()
+		it.Next()
+		m.Remove("a")
+		it.Next()
+	}()
+
+	// checked iterator stops and reports the error
+	checked := m.CheckedIterator()
+	checked.Next()
+	m.Put("e", 5)
+	if checked.Next() {
+		t.Errorf("Should not iterate after modification")
+	}
+	if actualValue, expectedValue := checked.Err(), containers.ErrConcurrentModification; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checked.Begin()
+	if actualValue := checked.Err(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	count = 0
+	for checked.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, m.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapIteratorClone(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+
+	it := m.Iterator()
+	it.Next()
+	clone := it.Clone()
+	first := it.Key()
+	it.Next()
+	if actualValue, expectedValue := clone.Key(), first; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !clone.Next() || clone.Key() != it.Key() {
+		t.Errorf("Got %v expected %v", clone.Key(), it.Key())
+	}
+	clone.Next()
+	if clone.Next() {
+		t.Errorf("Should not go past the last element")
+	}
+	if !it.Next() {
+		t.Errorf("Should move independently of the clone")
+	}
+}
+
+func TestMapIteratorRemove(t *testing.T) {
+	m := NewWithIntComparator()
+	for i := 1; i <= 100; i++ {
+		m.Put(i, i)
+	}
+	it := m.Iterator()
+	if it.Remove() {
+		t.Errorf("Should not remove before the first element")
+	}
+	for it.Next() {
+		if it.Key().(int)%3 == 0 && !it.Remove() {
+			t.Errorf("Should remove %v", it.Key())
+		}
+	}
+	for it.Prev() {
+		if it.Key().(int)%5 == 0 && !it.Remove() {
+			t.Errorf("Should remove %v", it.Key())
+		}
+	}
+	expected := []interface{}{}
+	for i := 1; i <= 100; i++ {
+		if i%3 != 0 && i%5 != 0 {
+			expected = append(expected, i)
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), fmt.Sprintf("%v", expected); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Size(), len(expected); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+}
+
+func TestMapIteratorRemoveNeighbours(t *testing.T) {
+	m := NewWithIntComparator()
+	for i := 1; i <= 100; i++ {
+		if i%3 != 0 && i%5 != 0 {
+			m.Put(i, i)
+		}
+	}
+	it := m.Iterator()
+
+	// the iterator is left between the neighbours of the removed element
+	it.First()
+	it.Next()
+	it.Remove()
+	if !it.Prev() || it.Key() != 1 {
+		t.Errorf("Got %v expected %v", it.Key(), 1)
+	}
+	if !it.Next() || it.Key() != 4 {
+		t.Errorf("Got %v expected %v", it.Key(), 4)
+	}
+	it.Last()
+	it.Remove()
+	if it.Next() {
+		t.Errorf("Should not move past the last element")
+	}
+	if !it.Prev() || it.Key() != 97 {
+		t.Errorf("Got %v expected %v", it.Key(), 97)
+	}
+}
+
+func TestMapIteratorSeek(t *testing.T) {
+	m := NewWithIntComparator()
+	for i := 2; i <= 20; i += 2 {
+		m.Put(i, i)
+	}
+	it := m.Iterator()
+	tests := [][]interface{}{
+		{it.SeekGE, 4, true, 4},
+		{it.SeekGE, 5, true, 6},
+		{it.SeekGE, 0, true, 2},
+		{it.SeekGE, 21, false, nil},
+		{it.SeekGT, 4, true, 6},
+		{it.SeekGT, 20, false, nil},
+		{it.SeekLE, 4, true, 4},
+		{it.SeekLE, 5, true, 4},
+		{it.SeekLE, 1, false, nil},
+		{it.SeekLT, 4, true, 2},
+		{it.SeekLT, 100, true, 20},
+		{it.SeekLT, 2, false, nil},
+	}
+	for _, test := range tests {
+		found := test[0].(func(interface{}) bool)(test[1])
+		if actualValue, expectedValue := found, test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if found && it.Key() != test[3] {
+			t.Errorf("Got %v expected %v", it.Key(), test[3])
+		}
+	}
+
+	// iteration continues from the sought element in both directions
+	it.SeekGE(5)
+	if !it.Next() || it.Key() != 8 {
+		t.Errorf("Got %v expected %v", it.Key(), 8)
+	}
+	it.SeekGE(5)
+	if !it.Prev() || it.Key() != 4 {
+		t.Errorf("Got %v expected %v", it.Key(), 4)
+	}
+	it.SeekGT(20)
+	if !it.Prev() || it.Key() != 20 {
+		t.Errorf("Got %v expected %v", it.Key(), 20)
+	}
+	it.SeekLT(2)
+	if !it.Next() || it.Key() != 2 {
+		t.Errorf("Got %v expected %v", it.Key(), 2)
+	}
+}
+
+func TestMapRangeIterator(t *testing.T) {
+	m := NewWithIntComparator()
+	for i := 2; i <= 20; i += 2 {
+		m.Put(i, i)
+	}
+	it := m.RangeIterator(5, 15)
+	keys := []interface{}{}
+	for it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[6 8 10 12 14]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	keys = []interface{}{}
+	for it.Prev() {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[14 12 10 8 6]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tests := [][]interface{}{
+		{it.First, 6},
+		{it.Last, 14},
+		{func() bool { return it.SeekGE(1) }, 6},
+		{func() bool { return it.SeekGT(10) }, 12},
+		{func() bool { return it.SeekLE(100) }, 14},
+		{func() bool { return it.SeekLT(6) }, nil},
+		{func() bool { return it.SeekGE(15) }, nil},
+	}
+	for _, test := range tests {
+		found := test[0].(func() bool)()
+		if actualValue, expectedValue := found, test[1] != nil; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if found && it.Key() != test[1] {
+			t.Errorf("Got %v expected %v", it.Key(), test[1])
+		}
+	}
+}
+
+func TestMapSeq(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	pairs := []string{}
+	for key, value := range m.AllSeq() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "a:1 b:2 c:3"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(m.KeysSeq())), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(m.ValuesSeq())), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	pairs = []string{}
+	for key, value := range m.Backward() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "c:3 b:2 a:1"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	count := 0
+	for key := range m.KeysSeq() {
+		if key == "b" {
+			break
+		}
+		count++
+	}
+	if actualValue, expectedValue := count, 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func benchmarkGet(b *testing.B, m *Map, size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
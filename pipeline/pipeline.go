@@ -0,0 +1,259 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package pipeline implements lazy pipelines over stateful iterators.
+//
+// A pipeline wraps an iterator and chains stages (Map, Filter, Take, Skip, TakeWhile, Zip, Chain, Distinct, Flatten)
+// that are evaluated one element at a time, only when a terminal operation (Each, ToSlice, ToArrayList, ToTreeMap, ...)
+// pulls elements through the pipeline. Nothing is allocated for the elements until the terminal operation.
+//
+// Pipeline is a sequence of values (over containers.IteratorWithIndex), PipelineWithKey is a sequence of key/value pairs
+// (over containers.IteratorWithKey or from zipping two pipelines).
+//
+// Pipelines are consumed by the terminal operation and by the stages built upon them, i.e. each pipeline can be used once.
+//
+// Example:
+//
+//	it := list.Iterator()
+//	values := pipeline.FromIteratorWithIndex(&it).
+//		Filter(func(index int, value interface{}) bool { return value.(int)%2 == 0 }).
+//		Map(func(index int, value interface{}) interface{} { return value.(int) * value.(int) }).
+//		Take(3).
+//		ToArrayList()
+package pipeline
+
+import (
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/lists/arraylist"
+)
+
+// Pipeline is a lazy sequence of values whose elements can be fetched by an index.
+type Pipeline struct {
+	next func() (interface{}, bool)
+}
+
+// FromIteratorWithIndex instantiates a pipeline over the values of the iterator, starting at its current position.
+// The iterator is advanced as the pipeline is consumed.
+func FromIteratorWithIndex(iterator containers.IteratorWithIndex) *Pipeline {
+	return &Pipeline{next: func() (interface{}, bool) {
+		if iterator.Next() {
+			return iterator.Value(), true
+		}
+		return nil, false
+	}}
+}
+
+// FromValues instantiates a pipeline over the passed values.
+func FromValues(values ...interface{}) *Pipeline {
+	index := 0
+	return &Pipeline{next: func() (interface{}, bool) {
+		if index >= len(values) {
+			return nil, false
+		}
+		index++
+		return values[index-1], true
+	}}
+}
+
+// Map returns a pipeline of the values returned by the given function for each value of the pipeline.
+func (pipeline *Pipeline) Map(f func(index int, value interface{}) interface{}) *Pipeline {
+	index := -1
+	return &Pipeline{next: func() (interface{}, bool) {
+		value, ok := pipeline.next()
+		if !ok {
+			return nil, false
+		}
+		index++
+		return f(index, value), true
+	}}
+}
+
+// Filter returns a pipeline of the values for which the given function returns a true value.
+func (pipeline *Pipeline) Filter(f func(index int, value interface{}) bool) *Pipeline {
+	index := -1
+	return &Pipeline{next: func() (interface{}, bool) {
+		for {
+			value, ok := pipeline.next()
+			if !ok {
+				return nil, false
+			}
+			index++
+			if f(index, value) {
+				return value, true
+			}
+		}
+	}}
+}
+
+// Take returns a pipeline of the first n values (or all values if there are less than n).
+func (pipeline *Pipeline) Take(n int) *Pipeline {
+	taken := 0
+	return &Pipeline{next: func() (interface{}, bool) {
+		if taken >= n {
+			return nil, false
+		}
+		taken++
+		return pipeline.next()
+	}}
+}
+
+// Skip returns a pipeline of the values after the first n values.
+func (pipeline *Pipeline) Skip(n int) *Pipeline {
+	skipped := 0
+	return &Pipeline{next: func() (interface{}, bool) {
+		for ; skipped < n; skipped++ {
+			if _, ok := pipeline.next(); !ok {
+				return nil, false
+			}
+		}
+		return pipeline.next()
+	}}
+}
+
+// TakeWhile returns a pipeline of the values up to (excluding) the first value for which the given function returns a false value.
+func (pipeline *Pipeline) TakeWhile(f func(index int, value interface{}) bool) *Pipeline {
+	index := -1
+	done := false
+	return &Pipeline{next: func() (interface{}, bool) {
+		if done {
+			return nil, false
+		}
+		value, ok := pipeline.next()
+		if !ok {
+			done = true
+			return nil, false
+		}
+		index++
+		if !f(index, value) {
+			done = true
+			return nil, false
+		}
+		return value, true
+	}}
+}
+
+// Zip returns a pipeline of key/value pairs whose keys are the values of this pipeline and values are the values of
+// the other pipeline. The resulting pipeline ends when either of the pipelines ends.
+func (pipeline *Pipeline) Zip(other *Pipeline) *PipelineWithKey {
+	return &PipelineWithKey{next: func() (interface{}, interface{}, bool) {
+		key, ok := pipeline.next()
+		if !ok {
+			return nil, nil, false
+		}
+		value, ok := other.next()
+		if !ok {
+			return nil, nil, false
+		}
+		return key, value, true
+	}}
+}
+
+// Chain returns a pipeline of the values of this pipeline followed by the values of the other pipelines.
+func (pipeline *Pipeline) Chain(others ...*Pipeline) *Pipeline {
+	pipelines := append([]*Pipeline{pipeline}, others...)
+	return &Pipeline{next: func() (interface{}, bool) {
+		for len(pipelines) > 0 {
+			if value, ok := pipelines[0].next(); ok {
+				return value, true
+			}
+			pipelines = pipelines[1:]
+		}
+		return nil, false
+	}}
+}
+
+// Distinct returns a pipeline of the values without repetitions, keeping the first occurrence of each value.
+// Values must be comparable with == (i.e. usable as map keys), otherwise the pipeline panics.
+func (pipeline *Pipeline) Distinct() *Pipeline {
+	seen := make(map[interface{}]struct{})
+	return &Pipeline{next: func() (interface{}, bool) {
+		for {
+			value, ok := pipeline.next()
+			if !ok {
+				return nil, false
+			}
+			if _, found := seen[value]; !found {
+				seen[value] = struct{}{}
+				return value, true
+			}
+		}
+	}}
+}
+
+// Flatten returns a pipeline of the values nested one level deep within the values of this pipeline.
+// Values that are pipelines, iterators (containers.IteratorWithIndex), containers (containers.Container)
+// or slices ([]interface{}) are replaced by their values, other values are passed as they are.
+func (pipeline *Pipeline) Flatten() *Pipeline {
+	var inner *Pipeline
+	return &Pipeline{next: func() (interface{}, bool) {
+		for {
+			if inner != nil {
+				if value, ok := inner.next(); ok {
+					return value, true
+				}
+				inner = nil
+			}
+			value, ok := pipeline.next()
+			if !ok {
+				return nil, false
+			}
+			switch nested := value.(type) {
+			case *Pipeline:
+				inner = nested
+			case containers.IteratorWithIndex:
+				inner = FromIteratorWithIndex(nested)
+			case containers.Container:
+				inner = FromValues(nested.Values()...)
+			case []interface{}:
+				inner = FromValues(nested...)
+			default:
+				return value, true
+			}
+		}
+	}}
+}
+
+// Next moves the pipeline to the next value and returns the value and true if there was a next value,
+// otherwise nil and false.
+func (pipeline *Pipeline) Next() (interface{}, bool) {
+	return pipeline.next()
+}
+
+// Each calls the given function once for each value of the pipeline, passing that value's index and value.
+func (pipeline *Pipeline) Each(f func(index int, value interface{})) {
+	for index := 0; ; index++ {
+		value, ok := pipeline.next()
+		if !ok {
+			return
+		}
+		f(index, value)
+	}
+}
+
+// Count returns the number of values of the pipeline.
+func (pipeline *Pipeline) Count() int {
+	count := 0
+	pipeline.Each(func(index int, value interface{}) {
+		count++
+	})
+	return count
+}
+
+// ToSlice returns the values of the pipeline.
+func (pipeline *Pipeline) ToSlice() []interface{} {
+	values := []interface{}{}
+	pipeline.Each(func(index int, value interface{}) {
+		values = append(values, value)
+	})
+	return values
+}
+
+// ToArrayList returns a list containing the values of the pipeline.
+func (pipeline *Pipeline) ToArrayList() *arraylist.List {
+	list := arraylist.New()
+	pipeline.Each(func(index int, value interface{}) {
+		list.Add(value)
+	})
+	return list
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pipeline
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/uncle-gua/gods/lists/arraylist"
+	"github.com/uncle-gua/gods/maps/treemap"
+	"github.com/uncle-gua/gods/sets/treeset"
+	"github.com/uncle-gua/gods/utils"
+)
+
+func TestPipelineLazy(t *testing.T) {
+	list := arraylist.New()
+	for i := 1; i <= 100; i++ {
+		list.Add(i)
+	}
+	it := list.Iterator()
+	filtered, mapped := 0, 0
+	values := FromIteratorWithIndex(&it).
+		Filter(func(index int, value interface{}) bool {
+			filtered++
+			return value.(int)%2 == 0
+		}).
+		Map(func(index int, value interface{}) interface{} {
+			mapped++
+			return value.(int) * value.(int)
+		}).
+		Take(3)
+	if filtered != 0 || mapped != 0 {
+		t.Errorf("Got %v,%v expected %v,%v", filtered, mapped, 0, 0)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", values.ToArrayList().Values()), "[4 16 36]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if filtered != 6 || mapped != 3 {
+		t.Errorf("Got %v,%v expected %v,%v", filtered, mapped, 6, 3)
+	}
+	if actualValue, expectedValue := it.Index(), 5; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestPipelineStages(t *testing.T) {
+	isOdd := func(index int, value interface{}) bool {
+		return value.(int)%2 == 1
+	}
+
+	// pipeline,expected
+	tests := [][]interface{}{
+		{FromValues(), "[]"},
+		{FromValues(1, 2, 3).Map(func(index int, value interface{}) interface{} { return index }), "[0 1 2]"},
+		{FromValues(1, 2, 3, 4).Filter(isOdd), "[1 3]"},
+		{FromValues(1, 2, 3).Take(0), "[]"},
+		{FromValues(1, 2, 3).Take(5), "[1 2 3]"},
+		{FromValues(1, 2, 3).Skip(2), "[3]"},
+		{FromValues(1, 2, 3).Skip(5), "[]"},
+		{FromValues(1, 3, 4, 5).TakeWhile(isOdd), "[1 3]"},
+		{FromValues(1, 2).Chain(FromValues(), FromValues(3), FromValues(4, 5)), "[1 2 3 4 5]"},
+		{FromValues(1, 2, 1, 3, 2).Distinct(), "[1 2 3]"},
+		{FromValues([]interface{}{1, 2}, 3, FromValues(4, 5), arraylist.New(6), []interface{}{}).Flatten(), "[1 2 3 4 5 6]"},
+		{FromValues(1, 2, 3).Zip(FromValues("a", "b")).Keys(), "[1 2]"},
+		{FromValues(1, 2, 3).Zip(FromValues("a", "b")).Values(), "[a b]"},
+	}
+
+	for i, test := range tests {
+		actualValue := fmt.Sprintf("%v", test[0].(*Pipeline).ToSlice())
+		if expectedValue := test[1]; actualValue != expectedValue {
+			t.Errorf("Test %v: Got %v expected %v", i, actualValue, expectedValue)
+		}
+	}
+}
+
+func TestPipelineFlattenIterators(t *testing.T) {
+	set1 := treeset.NewWithIntComparator(3, 1, 2)
+	set2 := treeset.NewWithIntComparator(5, 4)
+	it1, it2 := set1.Iterator(), set2.Iterator()
+	count := FromValues(&it1, &it2).Flatten().Filter(func(index int, value interface{}) bool {
+		return value.(int) > 1
+	}).Count()
+	if actualValue, expectedValue := count, 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestPipelineNext(t *testing.T) {
+	pipeline := FromValues("a", "b")
+	if value, ok := pipeline.Next(); value != "a" || !ok {
+		t.Errorf("Got %v,%v expected %v,%v", value, ok, "a", true)
+	}
+	if value, ok := pipeline.Next(); value != "b" || !ok {
+		t.Errorf("Got %v,%v expected %v,%v", value, ok, "b", true)
+	}
+	if value, ok := pipeline.Next(); value != nil || ok {
+		t.Errorf("Got %v,%v expected %v,%v", value, ok, nil, false)
+	}
+}
+
+func TestPipelineWithKey(t *testing.T) {
+	m := treemap.NewWithStringComparator()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+	m.Put("e", 5)
+
+	it := m.Iterator()
+	result := FromIteratorWithKey(&it).
+		Filter(func(key interface{}, value interface{}) bool {
+			return value.(int) > 1
+		}).
+		Map(func(key interface{}, value interface{}) (interface{}, interface{}) {
+			return value, key
+		}).
+		Skip(1).
+		Take(2).
+		ToTreeMap(utils.IntComparator)
+	if actualValue, expectedValue := fmt.Sprintf("%v %v", result.Keys(), result.Values()), "[3 4] [c d]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	it = m.Iterator()
+	keys := FromIteratorWithKey(&it).TakeWhile(func(key interface{}, value interface{}) bool {
+		return key != "c"
+	}).Chain(FromValues("a", "x").Zip(FromValues(9, 8))).Distinct().Keys().ToSlice()
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[a b x]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	it = m.Iterator()
+	if actualValue, expectedValue := FromIteratorWithKey(&it).Count(), 5; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if key, value, ok := FromIteratorWithKey(&it).Next(); key != nil || value != nil || ok {
+		t.Errorf("Got %v,%v,%v expected %v,%v,%v", key, value, ok, nil, nil, false)
+	}
+}
+
+func BenchmarkPipeline(b *testing.B) {
+	list := arraylist.New()
+	for i := 0; i < 10000; i++ {
+		list.Add(i)
+	}
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		it := list.Iterator()
+		FromIteratorWithIndex(&it).
+			Filter(func(index int, value interface{}) bool { return value.(int)%3 == 0 }).
+			Map(func(index int, value interface{}) interface{} { return value.(int) * 2 }).
+			Count()
+	}
+}
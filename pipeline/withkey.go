@@ -0,0 +1,179 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pipeline
+
+import (
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/maps/treemap"
+	"github.com/uncle-gua/gods/utils"
+)
+
+// PipelineWithKey is a lazy sequence of key/value pairs.
+type PipelineWithKey struct {
+	next func() (interface{}, interface{}, bool)
+}
+
+// FromIteratorWithKey instantiates a pipeline over the key/value pairs of the iterator, starting at its current position.
+// The iterator is advanced as the pipeline is consumed.
+func FromIteratorWithKey(iterator containers.IteratorWithKey) *PipelineWithKey {
+	return &PipelineWithKey{next: func() (interface{}, interface{}, bool) {
+		if iterator.Next() {
+			return iterator.Key(), iterator.Value(), true
+		}
+		return nil, nil, false
+	}}
+}
+
+// Map returns a pipeline of the key/value pairs returned by the given function for each pair of the pipeline.
+func (pipeline *PipelineWithKey) Map(f func(key1 interface{}, value1 interface{}) (interface{}, interface{})) *PipelineWithKey {
+	return &PipelineWithKey{next: func() (interface{}, interface{}, bool) {
+		key, value, ok := pipeline.next()
+		if !ok {
+			return nil, nil, false
+		}
+		key2, value2 := f(key, value)
+		return key2, value2, true
+	}}
+}
+
+// Filter returns a pipeline of the key/value pairs for which the given function returns a true value.
+func (pipeline *PipelineWithKey) Filter(f func(key interface{}, value interface{}) bool) *PipelineWithKey {
+	return &PipelineWithKey{next: func() (interface{}, interface{}, bool) {
+		for {
+			key, value, ok := pipeline.next()
+			if !ok {
+				return nil, nil, false
+			}
+			if f(key, value) {
+				return key, value, true
+			}
+		}
+	}}
+}
+
+// Take returns a pipeline of the first n key/value pairs (or all pairs if there are less than n).
+func (pipeline *PipelineWithKey) Take(n int) *PipelineWithKey {
+	taken := 0
+	return &PipelineWithKey{next: func() (interface{}, interface{}, bool) {
+		if taken >= n {
+			return nil, nil, false
+		}
+		taken++
+		return pipeline.next()
+	}}
+}
+
+// Skip returns a pipeline of the key/value pairs after the first n pairs.
+func (pipeline *PipelineWithKey) Skip(n int) *PipelineWithKey {
+	skipped := 0
+	return &PipelineWithKey{next: func() (interface{}, interface{}, bool) {
+		for ; skipped < n; skipped++ {
+			if _, _, ok := pipeline.next(); !ok {
+				return nil, nil, false
+			}
+		}
+		return pipeline.next()
+	}}
+}
+
+// TakeWhile returns a pipeline of the key/value pairs up to (excluding) the first pair for which the given function returns a false value.
+func (pipeline *PipelineWithKey) TakeWhile(f func(key interface{}, value interface{}) bool) *PipelineWithKey {
+	done := false
+	return &PipelineWithKey{next: func() (interface{}, interface{}, bool) {
+		if done {
+			return nil, nil, false
+		}
+		key, value, ok := pipeline.next()
+		if !ok || !f(key, value) {
+			done = true
+			return nil, nil, false
+		}
+		return key, value, true
+	}}
+}
+
+// Chain returns a pipeline of the key/value pairs of this pipeline followed by the pairs of the other pipelines.
+func (pipeline *PipelineWithKey) Chain(others ...*PipelineWithKey) *PipelineWithKey {
+	pipelines := append([]*PipelineWithKey{pipeline}, others...)
+	return &PipelineWithKey{next: func() (interface{}, interface{}, bool) {
+		for len(pipelines) > 0 {
+			if key, value, ok := pipelines[0].next(); ok {
+				return key, value, true
+			}
+			pipelines = pipelines[1:]
+		}
+		return nil, nil, false
+	}}
+}
+
+// Distinct returns a pipeline of the key/value pairs without repeated keys, keeping the first pair for each key.
+// Keys must be comparable with == (i.e. usable as map keys), otherwise the pipeline panics.
+func (pipeline *PipelineWithKey) Distinct() *PipelineWithKey {
+	seen := make(map[interface{}]struct{})
+	return &PipelineWithKey{next: func() (interface{}, interface{}, bool) {
+		for {
+			key, value, ok := pipeline.next()
+			if !ok {
+				return nil, nil, false
+			}
+			if _, found := seen[key]; !found {
+				seen[key] = struct{}{}
+				return key, value, true
+			}
+		}
+	}}
+}
+
+// Keys returns a pipeline of the keys of the pipeline.
+func (pipeline *PipelineWithKey) Keys() *Pipeline {
+	return &Pipeline{next: func() (interface{}, bool) {
+		key, _, ok := pipeline.next()
+		return key, ok
+	}}
+}
+
+// Values returns a pipeline of the values of the pipeline.
+func (pipeline *PipelineWithKey) Values() *Pipeline {
+	return &Pipeline{next: func() (interface{}, bool) {
+		_, value, ok := pipeline.next()
+		return value, ok
+	}}
+}
+
+// Next moves the pipeline to the next key/value pair and returns the pair and true if there was a next pair,
+// otherwise nil, nil and false.
+func (pipeline *PipelineWithKey) Next() (interface{}, interface{}, bool) {
+	return pipeline.next()
+}
+
+// Each calls the given function once for each key/value pair of the pipeline.
+func (pipeline *PipelineWithKey) Each(f func(key interface{}, value interface{})) {
+	for {
+		key, value, ok := pipeline.next()
+		if !ok {
+			return
+		}
+		f(key, value)
+	}
+}
+
+// Count returns the number of key/value pairs of the pipeline.
+func (pipeline *PipelineWithKey) Count() int {
+	count := 0
+	pipeline.Each(func(key interface{}, value interface{}) {
+		count++
+	})
+	return count
+}
+
+// ToTreeMap returns a map ordered by the given comparator containing the key/value pairs of the pipeline.
+// Later pairs replace earlier pairs with the same key.
+func (pipeline *PipelineWithKey) ToTreeMap(comparator utils.Comparator) *treemap.Map {
+	m := treemap.NewWith(comparator)
+	pipeline.Each(func(key interface{}, value interface{}) {
+		m.Put(key, value)
+	})
+	return m
+}
@@ -22,7 +22,8 @@ var _ queues.Queue = (*Queue)(nil)
 
 // Queue holds elements in an array-list
 type Queue struct {
-	list *arraylist.List
+	list     *arraylist.List
+	modCount int // number of structural modifications, checked by iterators
 }
 
 // New instantiates a new empty queue
@@ -32,6 +33,7 @@ func New() *Queue {
 
 // Enqueue adds a value to the end of the queue
 func (queue *Queue) Enqueue(value interface{}) {
+	queue.modCount++
 	queue.list.Add(value)
 }
 
@@ -40,6 +42,7 @@ func (queue *Queue) Enqueue(value interface{}) {
 func (queue *Queue) Dequeue() (value interface{}, ok bool) {
 	value, ok = queue.list.Get(0)
 	if ok {
+		queue.modCount++
 		queue.list.Remove(0)
 	}
 	return
@@ -63,6 +66,7 @@ func (queue *Queue) Size() int {
 
 // Clear removes all elements from the queue.
 func (queue *Queue) Clear() {
+	queue.modCount++
 	queue.list.Clear()
 }
 
@@ -23,12 +23,13 @@ var _ queues.Queue = (*Queue)(nil)
 
 // Queue holds values in a slice.
 type Queue struct {
-	values  []interface{}
-	start   int
-	end     int
-	full    bool
-	maxSize int
-	size    int
+	values   []interface{}
+	start    int
+	end      int
+	full     bool
+	maxSize  int
+	size     int
+	modCount int // number of structural modifications, checked by iterators
 }
 
 // New instantiates a new empty queue with the specified size of maximum number of elements that it can hold.
@@ -44,6 +45,7 @@ func New(maxSize int) *Queue {
 
 // Enqueue adds a value to the end of the queue
 func (queue *Queue) Enqueue(value interface{}) {
+	queue.modCount++
 	if queue.Full() {
 		queue.Dequeue()
 	}
@@ -66,6 +68,7 @@ func (queue *Queue) Dequeue() (value interface{}, ok bool) {
 		return nil, false
 	}
 
+	queue.modCount++
 	value, ok = queue.values[queue.start], true
 
 	if value != nil {
@@ -108,6 +111,7 @@ func (queue *Queue) Size() int {
 
 // Clear removes all elements from the queue.
 func (queue *Queue) Clear() {
+	queue.modCount++
 	queue.values = make([]interface{}, queue.maxSize, queue.maxSize)
 	queue.start = 0
 	queue.end = 0
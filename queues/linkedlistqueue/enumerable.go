@@ -0,0 +1,210 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linkedlistqueue
+
+import (
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/lists/arraylist"
+	"github.com/uncle-gua/gods/utils"
+)
+
+// Assert Enumerable implementation
+var _ containers.EnumerableWithIndex = (*Queue)(nil)
+
+// Each calls the given function once for each element, passing that element's index and value.
+func (queue *Queue) Each(f func(index int, value interface{})) {
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		f(iterator.Index(), iterator.Value())
+	}
+}
+
+// Map invokes the given function once for each element and returns a
+// container containing the values returned by the given function.
+func (queue *Queue) Map(f func(index int, value interface{}) interface{}) *Queue {
+	newQueue := New()
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		newQueue.Enqueue(f(iterator.Index(), iterator.Value()))
+	}
+	return newQueue
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+func (queue *Queue) Select(f func(index int, value interface{}) bool) *Queue {
+	newQueue := New()
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			newQueue.Enqueue(iterator.Value())
+		}
+	}
+	return newQueue
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+func (queue *Queue) Any(f func(index int, value interface{}) bool) bool {
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+func (queue *Queue) All(f func(index int, value interface{}) bool) bool {
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		if !f(iterator.Index(), iterator.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (index,value) for which the function is true or -1,nil otherwise
+// if no element matches the criteria.
+func (queue *Queue) Find(f func(index int, value interface{}) bool) (int, interface{}) {
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return iterator.Index(), iterator.Value()
+		}
+	}
+	return -1, nil
+}
+
+// Fold passes each element of the container to the given function together with the accumulated value,
+// starting with the initial value, and returns the last value returned by the function (or the initial value).
+func (queue *Queue) Fold(initial interface{}, f func(accumulator interface{}, index int, value interface{}) interface{}) interface{} {
+	accumulator := initial
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		accumulator = f(accumulator, iterator.Index(), iterator.Value())
+	}
+	return accumulator
+}
+
+// Reduce works like Fold, starting with the first element's value as the accumulated value,
+// and returns nil if the container is empty.
+func (queue *Queue) Reduce(f func(accumulator interface{}, index int, value interface{}) interface{}) interface{} {
+	iterator := queue.Iterator()
+	if !iterator.Next() {
+		return nil
+	}
+	accumulator := iterator.Value()
+	for iterator.Next() {
+		accumulator = f(accumulator, iterator.Index(), iterator.Value())
+	}
+	return accumulator
+}
+
+// Count returns the number of elements for which the given function returns a true value.
+func (queue *Queue) Count(f func(index int, value interface{}) bool) int {
+	count := 0
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			count++
+		}
+	}
+	return count
+}
+
+// GroupBy puts each element into a group stored in the given map under the key returned by the given function.
+// Groups are new array lists of the values in iteration order, the map should not hold any other values.
+func (queue *Queue) GroupBy(f func(index int, value interface{}) interface{}, groups containers.Groups) {
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		key := f(iterator.Index(), iterator.Value())
+		group, found := groups.Get(key)
+		if !found {
+			group = arraylist.New()
+			groups.Put(key, group)
+		}
+		group.(*arraylist.List).Add(iterator.Value())
+	}
+}
+
+// Partition returns a new queue containing all elements for which the given function returns a true value
+// and a new queue containing the rest of the elements.
+func (queue *Queue) Partition(f func(index int, value interface{}) bool) (containers.Container, containers.Container) {
+	selected, rest := New(), New()
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			selected.Enqueue(iterator.Value())
+		} else {
+			rest.Enqueue(iterator.Value())
+		}
+	}
+	return selected, rest
+}
+
+// MinBy returns the first smallest (index,value) according to the given comparator of values
+// or -1,nil if the container is empty.
+func (queue *Queue) MinBy(comparator utils.Comparator) (int, interface{}) {
+	index, value := -1, interface{}(nil)
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		if index == -1 || comparator(iterator.Value(), value) < 0 {
+			index, value = iterator.Index(), iterator.Value()
+		}
+	}
+	return index, value
+}
+
+// MaxBy returns the first largest (index,value) according to the given comparator of values
+// or -1,nil if the container is empty.
+func (queue *Queue) MaxBy(comparator utils.Comparator) (int, interface{}) {
+	index, value := -1, interface{}(nil)
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		if index == -1 || comparator(iterator.Value(), value) > 0 {
+			index, value = iterator.Index(), iterator.Value()
+		}
+	}
+	return index, value
+}
+
+// SumInt returns the sum of the numbers returned by the given function for each element.
+func (queue *Queue) SumInt(f func(index int, value interface{}) int) int {
+	var sum int
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		sum += f(iterator.Index(), iterator.Value())
+	}
+	return sum
+}
+
+// SumFloat64 returns the sum of the numbers returned by the given function for each element.
+func (queue *Queue) SumFloat64(f func(index int, value interface{}) float64) float64 {
+	var sum float64
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		sum += f(iterator.Index(), iterator.Value())
+	}
+	return sum
+}
+
+// Distinct returns a new queue containing the elements without repeated values, keeping the first occurrence of each value.
+// Values must be comparable with == (i.e. usable as map keys), otherwise the method panics.
+func (queue *Queue) Distinct() containers.Container {
+	newQueue := New()
+	seen := make(map[interface{}]struct{})
+	iterator := queue.Iterator()
+	for iterator.Next() {
+		if _, found := seen[iterator.Value()]; !found {
+			seen[iterator.Value()] = struct{}{}
+			newQueue.Enqueue(iterator.Value())
+		}
+	}
+	return newQueue
+}
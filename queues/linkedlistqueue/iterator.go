@@ -4,20 +4,36 @@
 
 package linkedlistqueue
 
-import "github.com/uncle-gua/gods/containers"
+import (
+	"iter"
+
+	"github.com/uncle-gua/gods/containers"
+)
 
 // Assert Iterator implementation
 var _ containers.IteratorWithIndex = (*Iterator)(nil)
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
 type Iterator struct {
-	queue *Queue
-	index int
+	queue    *Queue
+	index    int
+	failFast containers.FailFast
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
+// The iterator fails fast: moving it after the queue was structurally modified other than through the iterator
+// panics with containers.ErrConcurrentModification. Modifications made while the iterator is in its initial
+// (one-before-first) state, or before it is reset by Begin(), are allowed.
 func (queue *Queue) Iterator() Iterator {
-	return Iterator{queue: queue, index: -1}
+	return Iterator{queue: queue, index: -1, failFast: containers.NewFailFast(queue.modCount)}
+}
+
+// CheckedIterator returns a stateful iterator like Iterator(), except that instead of panicking when the queue was
+// modified during iteration, it stops and reports containers.ErrConcurrentModification by Err().
+func (queue *Queue) CheckedIterator() Iterator {
+	iterator := queue.Iterator()
+	iterator.failFast.Check()
+	return iterator
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -25,6 +41,9 @@ func (queue *Queue) Iterator() Iterator {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator) Next() bool {
+	if !iterator.failFast.Move(iterator.queue.modCount, iterator.index == -1) {
+		return false
+	}
 	if iterator.index < iterator.queue.Size() {
 		iterator.index++
 	}
@@ -44,10 +63,18 @@ func (iterator *Iterator) Index() int {
 	return iterator.index
 }
 
+// Clone returns an independent copy of the iterator at the same position, e.g. for lookahead or backtracking.
+// Moving either of the iterators does not move the other one.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Clone() Iterator {
+	return *iterator
+}
+
 // Begin resets the iterator to its initial state (one-before-first)
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator) Begin() {
 	iterator.index = -1
+	iterator.sync()
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -71,3 +98,40 @@ func (iterator *Iterator) NextTo(f func(index int, value interface{}) bool) bool
 	}
 	return false
 }
+
+// Err returns containers.ErrConcurrentModification if the checked iterator stopped because the queue was modified
+// during iteration, otherwise nil.
+func (iterator *Iterator) Err() error {
+	return iterator.failFast.Err()
+}
+
+// sync accepts the current state of the queue, i.e. after the iterator was reset.
+func (iterator *Iterator) sync() {
+	iterator.failFast.Sync(iterator.queue.modCount)
+}
+
+// AllSeq returns an iterator over index-value pairs of the queue in iteration order, for use with range-over-func.
+// It is not named All, which is the predicate of Enumerable.
+func (queue *Queue) AllSeq() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		iterator := queue.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Index(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over values of the queue in iteration order, for use with range-over-func.
+// It is not named Values, which returns the values as a slice.
+func (queue *Queue) ValuesSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		iterator := queue.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Value()) {
+				return
+			}
+		}
+	}
+}
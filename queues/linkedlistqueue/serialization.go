@@ -19,6 +19,7 @@ func (queue *Queue) ToJSON() ([]byte, error) {
 
 // FromJSON populates the queue from the input JSON representation.
 func (queue *Queue) FromJSON(data []byte) error {
+	queue.modCount++
 	return queue.list.FromJSON(data)
 }
 
@@ -7,8 +7,12 @@ package priorityqueue
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/lists/arraylist"
+	"github.com/uncle-gua/gods/maps/hashmap"
 	"github.com/uncle-gua/gods/utils"
 	"math/rand"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -473,6 +477,322 @@ func TestBTreeString(t *testing.T) {
 	}
 }
 
+func TestBinaryQueueIteratorConcurrentModification(t *testing.T) {
+	queue := NewWith(utils.StringComparator)
+	queue.Enqueue("a")
+	queue.Enqueue("b")
+	queue.Enqueue("c")
+
+	// modifications before the first element are allowed
+	it := queue.Iterator()
+	queue.Enqueue("d")
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, queue.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// modifications during iteration panic
+	func() {
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		it := queue.Iterator()
+		it.Next()
+		queue.Dequeue()
+		it.Next()
+	}()
+
+	// checked iterator stops and reports the error
+	checked := queue.CheckedIterator()
+	checked.Next()
+	queue.Enqueue("e")
+	if checked.Next() {
+		t.Errorf("Should not iterate after modification")
+	}
+	if actualValue, expectedValue := checked.Err(), containers.ErrConcurrentModification; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checked.Begin()
+	if actualValue := checked.Err(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	count = 0
+	for checked.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, queue.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryQueueIteratorClone(t *testing.T) {
+	queue := NewWith(utils.StringComparator)
+	queue.Enqueue("a")
+	queue.Enqueue("b")
+	queue.Enqueue("c")
+
+	it := queue.Iterator()
+	it.Next()
+	clone := it.Clone()
+	first := it.Value()
+	it.Next()
+	if actualValue, expectedValue := clone.Value(), first; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !clone.Next() || clone.Value() != it.Value() {
+		t.Errorf("Got %v expected %v", clone.Value(), it.Value())
+	}
+	clone.Next()
+	if clone.Next() {
+		t.Errorf("Should not go past the last element")
+	}
+	if !it.Next() {
+		t.Errorf("Should move independently of the clone")
+	}
+}
+
+func TestBinaryQueueEach(t *testing.T) {
+	queue := NewWith(utils.IntComparator)
+	queue.Enqueue(3)
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	values := []interface{}{}
+	queue.Each(func(index int, value interface{}) {
+		if actualValue, expectedValue := index, len(values); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		values = append(values, value)
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", values), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryQueueMap(t *testing.T) {
+	queue := NewWith(utils.IntComparator)
+	queue.Enqueue(3)
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	mapped := queue.Map(func(index int, value interface{}) interface{} {
+		return value.(int) * 10
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(mapped.ValuesSeq())), "[10 20 30]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(queue.ValuesSeq())), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryQueueMapWith(t *testing.T) {
+	queue := NewWith(utils.IntComparator)
+	queue.Enqueue(3)
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	// the values change their type, so that they are ordered by a comparator of the new type
+	mapped := queue.MapWith(utils.StringComparator, func(index int, value interface{}) interface{} {
+		return strings.Repeat("a", 4-value.(int))
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(mapped.ValuesSeq())), "[a aa aaa]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(queue.ValuesSeq())), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryQueueSelect(t *testing.T) {
+	queue := NewWith(utils.IntComparator)
+	queue.Enqueue(3)
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	selected := queue.Select(func(index int, value interface{}) bool {
+		return value.(int) != 2
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(selected.ValuesSeq())), "[1 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := queue.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryQueueGroupBy(t *testing.T) {
+	queue := NewWith(utils.IntComparator)
+	queue.Enqueue(3)
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(1)
+	groups := hashmap.New()
+	queue.GroupBy(func(index int, value interface{}) interface{} {
+		return value.(int)%2 == 0
+	}, groups)
+	if actualValue, expectedValue := groups.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tests := [][]interface{}{
+		{true, "[2]"},
+		{false, "[1 1 3]"},
+	}
+	for _, test := range tests {
+		group, _ := groups.Get(test[0])
+		if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(group.(*arraylist.List), utils.IntComparator)), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestBinaryQueuePartition(t *testing.T) {
+	queue := NewWith(utils.IntComparator)
+	queue.Enqueue(3)
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(1)
+	selected, rest := queue.Partition(func(index int, value interface{}) bool {
+		return value.(int)%2 == 0
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(selected, utils.IntComparator)), "[2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(rest, utils.IntComparator)), "[1 1 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryQueueDistinct(t *testing.T) {
+	queue := NewWith(utils.IntComparator)
+	queue.Enqueue(3)
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(1)
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(queue.Distinct(), utils.IntComparator)), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := queue.Size(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryQueueAnyAll(t *testing.T) {
+	queue := NewWith(utils.IntComparator)
+	queue.Enqueue(3)
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	tests := [][]interface{}{
+		{queue.Any, 2, true},
+		{queue.Any, 3, false},
+		{queue.All, 0, true},
+		{queue.All, 1, false},
+	}
+	for _, test := range tests {
+		f, bound := test[0].(func(func(index int, value interface{}) bool) bool), test[1].(int)
+		if actualValue, expectedValue := f(func(index int, value interface{}) bool {
+			return value.(int) > bound
+		}), test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestBinaryQueueFind(t *testing.T) {
+	queue := NewWith(utils.IntComparator)
+	queue.Enqueue(3)
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	foundIndex, foundValue := queue.Find(func(index int, value interface{}) bool {
+		return value.(int) == 2
+	})
+	if foundIndex != 1 || foundValue != 2 {
+		t.Errorf("Got %v:%v expected %v:%v", foundIndex, foundValue, 1, 2)
+	}
+	foundIndex, foundValue = queue.Find(func(index int, value interface{}) bool {
+		return value.(int) == 4
+	})
+	if foundIndex != -1 || foundValue != nil {
+		t.Errorf("Got %v:%v expected %v:%v", foundIndex, foundValue, -1, nil)
+	}
+}
+
+func TestBinaryQueueFold(t *testing.T) {
+	queue := NewWith(utils.IntComparator)
+	queue.Enqueue(3)
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	tests := [][]interface{}{
+		{queue.Fold(0, func(accumulator interface{}, index int, value interface{}) interface{} {
+			return accumulator.(int) + value.(int)
+		}), 6},
+		{queue.Reduce(func(accumulator interface{}, index int, value interface{}) interface{} {
+			return accumulator.(int)*10 + value.(int)
+		}), 123},
+		{queue.Count(func(index int, value interface{}) bool {
+			return value.(int)%2 == 1
+		}), 2},
+		{queue.SumInt(func(index int, value interface{}) int {
+			return value.(int)
+		}), 6},
+		{queue.SumFloat64(func(index int, value interface{}) float64 {
+			return float64(value.(int)) / 2
+		}), 3.0},
+	}
+	for _, test := range tests {
+		if actualValue, expectedValue := test[0], test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if _, actualValue := queue.MinBy(utils.IntComparator); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if _, actualValue := queue.MaxBy(utils.IntComparator); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, expectedValue := queue.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryQueueSeq(t *testing.T) {
+	queue := NewWith(utils.StringComparator)
+	queue.Enqueue("c")
+	queue.Enqueue("a")
+	queue.Enqueue("b")
+
+	pairs := []string{}
+	for index, value := range queue.AllSeq() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", index, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "0:a 1:b 2:c"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(queue.ValuesSeq())), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	pairs = []string{}
+	for index, value := range queue.Backward() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", index, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "2:c 1:b 0:a"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	count := 0
+	for index := range queue.AllSeq() {
+		if index == 1 {
+			break
+		}
+		count++
+	}
+	if actualValue, expectedValue := count, 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func benchmarkEnqueue(b *testing.B, queue *Queue, size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -0,0 +1,220 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashset
+
+import (
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/lists/arraylist"
+	"github.com/uncle-gua/gods/utils"
+)
+
+// Assert Enumerable implementation
+var _ containers.EnumerableWithIndex = (*Set)(nil)
+
+// Each calls the given function once for each element, passing that element's index and value.
+func (set *Set) Each(f func(index int, value interface{})) {
+	index := -1
+	for value := range set.items {
+		index++
+		f(index, value)
+	}
+}
+
+// Map invokes the given function once for each element and returns a
+// container containing the values returned by the given function.
+func (set *Set) Map(f func(index int, value interface{}) interface{}) *Set {
+	newSet := New()
+	index := -1
+	for value := range set.items {
+		index++
+		newSet.Add(f(index, value))
+	}
+	return newSet
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+func (set *Set) Select(f func(index int, value interface{}) bool) *Set {
+	newSet := New()
+	index := -1
+	for value := range set.items {
+		index++
+		if f(index, value) {
+			newSet.Add(value)
+		}
+	}
+	return newSet
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+func (set *Set) Any(f func(index int, value interface{}) bool) bool {
+	index := -1
+	for value := range set.items {
+		index++
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+func (set *Set) All(f func(index int, value interface{}) bool) bool {
+	index := -1
+	for value := range set.items {
+		index++
+		if !f(index, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (index,value) for which the function is true or -1,nil otherwise
+// if no element matches the criteria.
+func (set *Set) Find(f func(index int, value interface{}) bool) (int, interface{}) {
+	index := -1
+	for value := range set.items {
+		index++
+		if f(index, value) {
+			return index, value
+		}
+	}
+	return -1, nil
+}
+
+// Fold passes each element of the container to the given function together with the accumulated value,
+// starting with the initial value, and returns the last value returned by the function (or the initial value).
+func (set *Set) Fold(initial interface{}, f func(accumulator interface{}, index int, value interface{}) interface{}) interface{} {
+	accumulator := initial
+	index := -1
+	for value := range set.items {
+		index++
+		accumulator = f(accumulator, index, value)
+	}
+	return accumulator
+}
+
+// Reduce works like Fold, starting with the first element's value as the accumulated value,
+// and returns nil if the container is empty.
+func (set *Set) Reduce(f func(accumulator interface{}, index int, value interface{}) interface{}) interface{} {
+	var accumulator interface{}
+	index := -1
+	for value := range set.items {
+		index++
+		if index == 0 {
+			accumulator = value
+			continue
+		}
+		accumulator = f(accumulator, index, value)
+	}
+	return accumulator
+}
+
+// Count returns the number of elements for which the given function returns a true value.
+func (set *Set) Count(f func(index int, value interface{}) bool) int {
+	count := 0
+	index := -1
+	for value := range set.items {
+		index++
+		if f(index, value) {
+			count++
+		}
+	}
+	return count
+}
+
+// GroupBy puts each element into a group stored in the given map under the key returned by the given function.
+// Groups are new array lists of the values in iteration order, the map should not hold any other values.
+func (set *Set) GroupBy(f func(index int, value interface{}) interface{}, groups containers.Groups) {
+	index := -1
+	for value := range set.items {
+		index++
+		key := f(index, value)
+		group, found := groups.Get(key)
+		if !found {
+			group = arraylist.New()
+			groups.Put(key, group)
+		}
+		group.(*arraylist.List).Add(value)
+	}
+}
+
+// Partition returns a new set containing all elements for which the given function returns a true value
+// and a new set containing the rest of the elements.
+func (set *Set) Partition(f func(index int, value interface{}) bool) (containers.Container, containers.Container) {
+	selected, rest := New(), New()
+	index := -1
+	for value := range set.items {
+		index++
+		if f(index, value) {
+			selected.Add(value)
+		} else {
+			rest.Add(value)
+		}
+	}
+	return selected, rest
+}
+
+// MinBy returns the first smallest (index,value) according to the given comparator of values
+// or -1,nil if the container is empty.
+func (set *Set) MinBy(comparator utils.Comparator) (int, interface{}) {
+	foundIndex, foundValue := -1, interface{}(nil)
+	index := -1
+	for value := range set.items {
+		index++
+		if foundIndex == -1 || comparator(value, foundValue) < 0 {
+			foundIndex, foundValue = index, value
+		}
+	}
+	return foundIndex, foundValue
+}
+
+// MaxBy returns the first largest (index,value) according to the given comparator of values
+// or -1,nil if the container is empty.
+func (set *Set) MaxBy(comparator utils.Comparator) (int, interface{}) {
+	foundIndex, foundValue := -1, interface{}(nil)
+	index := -1
+	for value := range set.items {
+		index++
+		if foundIndex == -1 || comparator(value, foundValue) > 0 {
+			foundIndex, foundValue = index, value
+		}
+	}
+	return foundIndex, foundValue
+}
+
+// SumInt returns the sum of the numbers returned by the given function for each element.
+func (set *Set) SumInt(f func(index int, value interface{}) int) int {
+	var sum int
+	index := -1
+	for value := range set.items {
+		index++
+		sum += f(index, value)
+	}
+	return sum
+}
+
+// SumFloat64 returns the sum of the numbers returned by the given function for each element.
+func (set *Set) SumFloat64(f func(index int, value interface{}) float64) float64 {
+	var sum float64
+	index := -1
+	for value := range set.items {
+		index++
+		sum += f(index, value)
+	}
+	return sum
+}
+
+// Distinct returns a new set containing all values of the set, as a set holds no repeated values.
+func (set *Set) Distinct() containers.Container {
+	newSet := New()
+	for value := range set.items {
+		newSet.Add(value)
+	}
+	return newSet
+}
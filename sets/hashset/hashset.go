@@ -20,7 +20,8 @@ var _ sets.Set = (*Set)(nil)
 
 // Set holds elements in go's native map
 type Set struct {
-	items map[interface{}]struct{}
+	items    map[interface{}]struct{}
+	modCount int // number of structural modifications, checked by iterators
 }
 
 var itemExists = struct{}{}
@@ -37,6 +38,9 @@ func New(values ...interface{}) *Set {
 // Add adds the items (one or more) to the set.
 func (set *Set) Add(items ...interface{}) {
 	for _, item := range items {
+		if _, found := set.items[item]; !found {
+			set.modCount++
+		}
 		set.items[item] = itemExists
 	}
 }
@@ -44,7 +48,10 @@ func (set *Set) Add(items ...interface{}) {
 // Remove removes the items (one or more) from the set.
 func (set *Set) Remove(items ...interface{}) {
 	for _, item := range items {
-		delete(set.items, item)
+		if _, found := set.items[item]; found {
+			set.modCount++
+			delete(set.items, item)
+		}
 	}
 }
 
@@ -72,6 +79,7 @@ func (set *Set) Size() int {
 
 // Clear clears all values in the set.
 func (set *Set) Clear() {
+	set.modCount++
 	set.items = make(map[interface{}]struct{})
 }
 
@@ -6,8 +6,15 @@ package hashset
 
 import (
 	"encoding/json"
+	"fmt"
+	"slices"
 	"strings"
 	"testing"
+
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/lists/arraylist"
+	"github.com/uncle-gua/gods/maps/hashmap"
+	"github.com/uncle-gua/gods/utils"
 )
 
 func TestSetNew(t *testing.T) {
@@ -215,6 +222,435 @@ func benchmarkRemove(b *testing.B, set *Set, size int) {
 	}
 }
 
+func TestSetEach(t *testing.T) {
+	set := New()
+	set.Add("c", "a", "b")
+	indexes := []int{}
+	values := []interface{}{}
+	set.Each(func(index int, value interface{}) {
+		indexes = append(indexes, index)
+		values = append(values, value)
+	})
+	utils.Sort(values, utils.StringComparator)
+	if actualValue, expectedValue := fmt.Sprintf("%v %v", indexes, values), "[0 1 2] [a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetMap(t *testing.T) {
+	set := New()
+	set.Add("c", "a", "b")
+	mappedSet := set.Map(func(index int, value interface{}) interface{} {
+		return "mapped: " + value.(string)
+	})
+	if actualValue, expectedValue := mappedSet.Contains("mapped: a", "mapped: b", "mapped: c"), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := mappedSet.Contains("mapped: a", "mapped: b", "mapped: x"), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if mappedSet.Size() != 3 {
+		t.Errorf("Got %v expected %v", mappedSet.Size(), 3)
+	}
+}
+
+func TestSetSelect(t *testing.T) {
+	set := New()
+	set.Add("c", "a", "b")
+	selectedSet := set.Select(func(index int, value interface{}) bool {
+		return value.(string) >= "a" && value.(string) <= "b"
+	})
+	if actualValue, expectedValue := selectedSet.Contains("a", "b"), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := selectedSet.Contains("c"), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if selectedSet.Size() != 2 {
+		t.Errorf("Got %v expected %v", selectedSet.Size(), 2)
+	}
+}
+
+func TestSetAny(t *testing.T) {
+	set := New()
+	set.Add("c", "a", "b")
+	any := set.Any(func(index int, value interface{}) bool {
+		return value.(string) == "c"
+	})
+	if any != true {
+		t.Errorf("Got %v expected %v", any, true)
+	}
+	any = set.Any(func(index int, value interface{}) bool {
+		return value.(string) == "x"
+	})
+	if any != false {
+		t.Errorf("Got %v expected %v", any, false)
+	}
+}
+
+func TestSetEnumerableAllocations(t *testing.T) {
+	set := New()
+	for i := 0; i < 100; i++ {
+		set.Add(i)
+	}
+	count := 0
+	allocations := testing.AllocsPerRun(10, func() {
+		set.Each(func(index int, value interface{}) { count++ })
+		set.Any(func(index int, value interface{}) bool { return false })
+		set.Find(func(index int, value interface{}) bool { return false })
+	})
+	if allocations != 0 {
+		t.Errorf("Got %v expected %v", allocations, 0)
+	}
+	if actualValue, expectedValue := count, 1100; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetAll(t *testing.T) {
+	set := New()
+	set.Add("c", "a", "b")
+	all := set.All(func(index int, value interface{}) bool {
+		return value.(string) >= "a" && value.(string) <= "c"
+	})
+	if all != true {
+		t.Errorf("Got %v expected %v", all, true)
+	}
+	all = set.All(func(index int, value interface{}) bool {
+		return value.(string) >= "a" && value.(string) <= "b"
+	})
+	if all != false {
+		t.Errorf("Got %v expected %v", all, false)
+	}
+}
+
+func TestSetFind(t *testing.T) {
+	set := New()
+	set.Add("c", "a", "b")
+	foundIndex, foundValue := set.Find(func(index int, value interface{}) bool {
+		return value.(string) == "c"
+	})
+	if foundValue != "c" || foundIndex < 0 || foundIndex > 2 {
+		t.Errorf("Got %v at %v expected %v at [0,2]", foundValue, foundIndex, "c")
+	}
+	foundIndex, foundValue = set.Find(func(index int, value interface{}) bool {
+		return value.(string) == "x"
+	})
+	if foundValue != nil || foundIndex != -1 {
+		t.Errorf("Got %v at %v expected %v at %v", foundValue, foundIndex, nil, nil)
+	}
+}
+
+func TestSetFold(t *testing.T) {
+	c := New(1, 2, 3, 4)
+	if actualValue, expectedValue := c.Fold(10, func(accumulator interface{}, index int, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}), 20; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := c.Reduce(func(accumulator interface{}, index int, value interface{}) interface{} {
+		return accumulator.(int) * value.(int)
+	}), 24; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	empty := New()
+	if actualValue, expectedValue := empty.Fold(10, func(accumulator interface{}, index int, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := empty.Reduce(func(accumulator interface{}, index int, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestSetCount(t *testing.T) {
+	c := New(1, 2, 3, 4)
+	if actualValue, expectedValue := c.Count(func(index int, value interface{}) bool {
+		return value.(int)%2 == 0
+	}), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetGroupBy(t *testing.T) {
+	c := New(1, 2, 3, 4)
+	groups := hashmap.New()
+	c.GroupBy(func(index int, value interface{}) interface{} {
+		return value.(int)%2 == 0
+	}, groups)
+	if actualValue, expectedValue := groups.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tests := [][]interface{}{
+		{true, "[2 4]"},
+		{false, "[1 3]"},
+	}
+	for _, test := range tests {
+		group, _ := groups.Get(test[0])
+		if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(group.(*arraylist.List), utils.IntComparator)), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestSetPartition(t *testing.T) {
+	c := New(1, 2, 3, 4)
+	selected, rest := c.Partition(func(index int, value interface{}) bool {
+		return value.(int)%2 == 0
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(selected, utils.IntComparator)), "[2 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(rest, utils.IntComparator)), "[1 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetMinByMaxBy(t *testing.T) {
+	c := New(1, 2, 3, 4)
+	if _, actualValue := c.MinBy(utils.IntComparator); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if _, actualValue := c.MaxBy(utils.IntComparator); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	empty := New()
+	if index, value := empty.MinBy(utils.IntComparator); index != -1 || value != nil {
+		t.Errorf("Got %v expected %v", value, nil)
+	}
+	if index, value := empty.MaxBy(utils.IntComparator); index != -1 || value != nil {
+		t.Errorf("Got %v expected %v", value, nil)
+	}
+}
+
+func TestSetSum(t *testing.T) {
+	c := New(1, 2, 3, 4)
+	if actualValue, expectedValue := c.SumInt(func(index int, value interface{}) int {
+		return value.(int)
+	}), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := c.SumFloat64(func(index int, value interface{}) float64 {
+		return float64(value.(int)) / 2
+	}), 5.0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetDistinct(t *testing.T) {
+	set := New(1, 2, 3, 4)
+	if actualValue, expectedValue := set.Distinct().Size(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetIteratorNextOnEmpty(t *testing.T) {
+	set := New()
+	it := set.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty set")
+	}
+}
+
+func TestSetIteratorNext(t *testing.T) {
+	set := New()
+	set.Add("c", "a", "b")
+	it := set.Iterator()
+	count := 0
+	for it.Next() {
+		if actualValue, expectedValue := it.Index(), count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if !set.Contains(it.Value()) {
+			t.Errorf("Got %v not in set", it.Value())
+		}
+		count++
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if it.Next() {
+		t.Errorf("Should not go past last element")
+	}
+}
+
+func TestSetIteratorBegin(t *testing.T) {
+	set := New()
+	set.Add("a", "b", "c")
+	it := set.Iterator()
+	for it.Next() {
+	}
+	it.Begin()
+	it.Next()
+	if index := it.Index(); index != 0 {
+		t.Errorf("Got %v expected %v", index, 0)
+	}
+}
+
+func TestSetIteratorFirst(t *testing.T) {
+	set := New()
+	it := set.Iterator()
+	if actualValue, expectedValue := it.First(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.Add("a", "b", "c")
+	it = set.Iterator()
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if index := it.Index(); index != 0 || !set.Contains(it.Value()) {
+		t.Errorf("Got %v,%v expected %v,<element>", index, it.Value(), 0)
+	}
+}
+
+func TestSetIteratorNextTo(t *testing.T) {
+	// Sample seek function, i.e. string starting with "b"
+	seek := func(index int, value interface{}) bool {
+		return strings.HasSuffix(value.(string), "b")
+	}
+
+	// NextTo (empty)
+	{
+		set := New()
+		it := set.Iterator()
+		for it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty set")
+		}
+	}
+
+	// NextTo (not found)
+	{
+		set := New()
+		set.Add("xx", "yy")
+		it := set.Iterator()
+		for it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty set")
+		}
+	}
+
+	// NextTo (found)
+	{
+		set := New()
+		set.Add("aa", "bb", "cc")
+		it := set.Iterator()
+		it.Begin()
+		if !it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty set")
+		}
+		if value := it.Value(); value.(string) != "bb" {
+			t.Errorf("Got %v expected %v", value, "bb")
+		}
+		if it.NextTo(seek) {
+			t.Errorf("Should not find another element")
+		}
+	}
+}
+
+func TestSetIteratorConcurrentModification(t *testing.T) {
+	set := New("a", "b", "c")
+
+	// modifications before the first element are allowed
+	it := set.Iterator()
+	set.Add("d")
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, set.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// modifications during iteration panic
+	func() {
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		it := set.Iterator()
+		it.Next()
+		set.Remove("a")
+		it.Next()
+	}()
+
+	// checked iterator stops and reports the error
+	checked := set.CheckedIterator()
+	checked.Next()
+	set.Add("e")
+	if checked.Next() {
+		t.Errorf("Should not iterate after modification")
+	}
+	if actualValue, expectedValue := checked.Err(), containers.ErrConcurrentModification; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checked.Begin()
+	if actualValue := checked.Err(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	count = 0
+	for checked.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, set.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetIteratorClone(t *testing.T) {
+	set := New("a", "b", "c")
+
+	it := set.Iterator()
+	it.Next()
+	clone := it.Clone()
+	first := it.Value()
+	it.Next()
+	if actualValue, expectedValue := clone.Value(), first; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !clone.Next() || clone.Value() != it.Value() {
+		t.Errorf("Got %v expected %v", clone.Value(), it.Value())
+	}
+	clone.Next()
+	if clone.Next() {
+		t.Errorf("Should not go past the last element")
+	}
+	if !it.Next() {
+		t.Errorf("Should move independently of the clone")
+	}
+}
+
+func TestSetSeq(t *testing.T) {
+	set := New("c", "a", "b")
+
+	indexes := []int{}
+	values := []interface{}{}
+	for index, value := range set.AllSeq() {
+		indexes = append(indexes, index)
+		values = append(values, value)
+	}
+	utils.Sort(values, utils.StringComparator)
+	if actualValue, expectedValue := fmt.Sprintf("%v %v", indexes, values), "[0 1 2] [a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	values = slices.Collect(set.ValuesSeq())
+	utils.Sort(values, utils.StringComparator)
+	if actualValue, expectedValue := fmt.Sprintf("%v", values), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	count := 0
+	for range set.ValuesSeq() {
+		count++
+		break
+	}
+	if actualValue, expectedValue := count, 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func BenchmarkHashSetContains100(b *testing.B) {
 	b.StopTimer()
 	size := 100
@@ -0,0 +1,152 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashset
+
+import (
+	"iter"
+
+	"github.com/uncle-gua/gods/containers"
+)
+
+// Assert Iterator implementation
+var _ containers.IteratorWithIndex = (*Iterator)(nil)
+
+// Iterator holding the iterator's state
+type Iterator struct {
+	set      *Set
+	items    []interface{}
+	index    int
+	failFast containers.FailFast
+}
+
+// Iterator returns a stateful iterator whose values can be fetched by an index.
+// Values are iterated in random order, the values are taken from the set when the iterator is created or reset,
+// which copies all values in O(n) time and space. The enumerable methods (Each, Any, Find, ...) and the
+// range-over-func sequences range over the set directly instead.
+// The iterator fails fast: moving it after the set was structurally modified other than through the iterator
+// panics with containers.ErrConcurrentModification. Modifications made while the iterator is in its initial
+// (one-before-first) state, or before it is reset by Begin(), are allowed.
+func (set *Set) Iterator() Iterator {
+	return Iterator{set: set, items: set.Values(), index: -1, failFast: containers.NewFailFast(set.modCount)}
+}
+
+// CheckedIterator returns a stateful iterator like Iterator(), except that instead of panicking when the set was
+// modified during iteration, it stops and reports containers.ErrConcurrentModification by Err().
+func (set *Set) CheckedIterator() Iterator {
+	iterator := set.Iterator()
+	iterator.failFast.Check()
+	return iterator
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's index and value can be retrieved by Index() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator) Next() bool {
+	if iterator.index == -1 {
+		iterator.sync()
+	} else if iterator.modified() {
+		return false
+	}
+	if iterator.index < len(iterator.items) {
+		iterator.index++
+	}
+	return iterator.index < len(iterator.items)
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Value() interface{} {
+	return iterator.items[iterator.index]
+}
+
+// Index returns the current element's index.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Index() int {
+	return iterator.index
+}
+
+// Clone returns an independent copy of the iterator at the same position, e.g. for lookahead or backtracking.
+// Moving either of the iterators does not move the other one.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Clone() Iterator {
+	return *iterator
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator) Begin() {
+	iterator.index = -1
+	iterator.sync()
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If NextTo() returns true, then next element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) NextTo(f func(index int, value interface{}) bool) bool {
+	for iterator.Next() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns containers.ErrConcurrentModification if the checked iterator stopped because the set was modified
+// during iteration, otherwise nil.
+func (iterator *Iterator) Err() error {
+	return iterator.failFast.Err()
+}
+
+// sync accepts the current state of the set, i.e. after the iterator was reset,
+// taking the values anew if the set has changed.
+func (iterator *Iterator) sync() {
+	if !iterator.failFast.InSync(iterator.set.modCount) {
+		iterator.items = iterator.set.Values()
+	}
+	iterator.failFast.Sync(iterator.set.modCount)
+}
+
+// modified returns true if the set was modified since the iterator was synchronised with it.
+// Panics with containers.ErrConcurrentModification, unless the iterator is checked.
+func (iterator *Iterator) modified() bool {
+	return iterator.failFast.Modified(iterator.set.modCount)
+}
+
+// AllSeq returns an iterator over index-value pairs of the set in random order, for use with range-over-func.
+// It is not named All, which is the predicate of Enumerable.
+func (set *Set) AllSeq() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		index := 0
+		for item := range set.items {
+			if !yield(index, item) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over values of the set in random order, for use with range-over-func.
+// It is not named Values, which returns the values as a slice.
+func (set *Set) ValuesSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for item := range set.items {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
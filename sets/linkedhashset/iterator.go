@@ -5,6 +5,8 @@
 package linkedhashset
 
 import (
+	"iter"
+
 	"github.com/uncle-gua/gods/containers"
 	"github.com/uncle-gua/gods/lists/doublylinkedlist"
 )
@@ -18,10 +20,19 @@ type Iterator struct {
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
+// The iterator fails fast: moving it after the set was structurally modified other than through the iterator
+// panics with containers.ErrConcurrentModification. Modifications made while the iterator is in its initial
+// (one-before-first) state, or before it is reset by Begin() or End(), are allowed.
 func (set *Set) Iterator() Iterator {
 	return Iterator{iterator: set.ordering.Iterator()}
 }
 
+// CheckedIterator returns a stateful iterator like Iterator(), except that instead of panicking when the set was
+// modified during iteration, it stops and reports containers.ErrConcurrentModification by Err().
+func (set *Set) CheckedIterator() Iterator {
+	return Iterator{iterator: set.ordering.CheckedIterator()}
+}
+
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
 // If Next() returns true, then next element's index and value can be retrieved by Index() and Value().
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
@@ -49,6 +60,13 @@ func (iterator *Iterator) Index() int {
 	return iterator.iterator.Index()
 }
 
+// Clone returns an independent copy of the iterator at the same position, e.g. for lookahead or backtracking.
+// Moving either of the iterators does not move the other one.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Clone() Iterator {
+	return *iterator
+}
+
 // Begin resets the iterator to its initial state (one-before-first)
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator) Begin() {
@@ -102,3 +120,48 @@ func (iterator *Iterator) PrevTo(f func(index int, value interface{}) bool) bool
 	}
 	return false
 }
+
+// Err returns containers.ErrConcurrentModification if the checked iterator stopped because the set was modified
+// during iteration, otherwise nil.
+func (iterator *Iterator) Err() error {
+	return iterator.iterator.Err()
+}
+
+// AllSeq returns an iterator over index-value pairs of the set in iteration order, for use with range-over-func.
+// It is not named All, which is the predicate of Enumerable.
+func (set *Set) AllSeq() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		iterator := set.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Index(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over values of the set in iteration order, for use with range-over-func.
+// It is not named Values, which returns the values as a slice.
+func (set *Set) ValuesSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		iterator := set.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over index-value pairs of the set in reverse iteration order, for use with range-over-func.
+func (set *Set) Backward() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		iterator := set.Iterator()
+		iterator.End()
+		for iterator.Prev() {
+			if !yield(iterator.Index(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
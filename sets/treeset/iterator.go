@@ -5,6 +5,8 @@
 package treeset
 
 import (
+	"iter"
+
 	"github.com/uncle-gua/gods/containers"
 	rbt "github.com/uncle-gua/gods/trees/redblacktree"
 )
@@ -17,22 +19,52 @@ type Iterator struct {
 	index    int
 	iterator rbt.Iterator
 	tree     *rbt.Tree
+	bounded  bool
 }
 
+// unknownIndex marks the index of the current element as not counted yet, e.g. after seeking.
+const unknownIndex = -2
+
 // Iterator holding the iterator's state
+// The iterator fails fast: moving it after the set was structurally modified other than through the iterator
+// panics with containers.ErrConcurrentModification. Modifications made while the iterator is before the first
+// or past the last element, e.g. after Begin() or End(), are allowed.
 func (set *Set) Iterator() Iterator {
 	return Iterator{index: -1, iterator: set.tree.Iterator(), tree: set.tree}
 }
 
+// CheckedIterator returns a stateful iterator like Iterator(), except that instead of panicking when the set was
+// modified during iteration, it stops and reports containers.ErrConcurrentModification by Err().
+func (set *Set) CheckedIterator() Iterator {
+	return Iterator{index: -1, iterator: set.tree.CheckedIterator(), tree: set.tree}
+}
+
+// RangeIterator returns a stateful iterator like Iterator(), restricted to the values in the half-open range
+// [from, to), i.e. it moves from its initial state to the first value not smaller than from, and past its last
+// value when the next value would not be smaller than to (and vice versa in reverse).
+// Index() of a range iterator is the index within the whole set, counted in O(log n) time when first requested.
+// Values should adhere to the comparator's type assertion, otherwise method panics.
+func (set *Set) RangeIterator(from, to interface{}) Iterator {
+	return Iterator{index: unknownIndex, iterator: set.tree.RangeIterator(from, to), tree: set.tree, bounded: true}
+}
+
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
 // If Next() returns true, then next element's index and value can be retrieved by Index() and Value().
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator) Next() bool {
-	if iterator.index < iterator.tree.Size() {
+	if iterator.index != unknownIndex && iterator.index < iterator.tree.Size() {
 		iterator.index++
 	}
-	return iterator.iterator.Next()
+	if iterator.iterator.Next() {
+		return true
+	}
+	if iterator.bounded {
+		iterator.index = unknownIndex
+	} else if iterator.index == unknownIndex {
+		iterator.index = iterator.tree.Size()
+	}
+	return false
 }
 
 // Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
@@ -40,9 +72,21 @@ func (iterator *Iterator) Next() bool {
 // Modifies the state of the iterator.
 func (iterator *Iterator) Prev() bool {
 	if iterator.index >= 0 {
+		if iterator.iterator.Node() == nil {
+			// past the last element, the set may have changed since End()
+			iterator.index = iterator.tree.Size()
+		}
 		iterator.index--
 	}
-	return iterator.iterator.Prev()
+	if iterator.iterator.Prev() {
+		return true
+	}
+	if iterator.bounded {
+		iterator.index = unknownIndex
+	} else if iterator.index == unknownIndex {
+		iterator.index = -1
+	}
+	return false
 }
 
 // Value returns the current element's value.
@@ -52,15 +96,33 @@ func (iterator *Iterator) Value() interface{} {
 }
 
 // Index returns the current element's index.
+// After seeking, the index is counted in O(log n) time when first requested.
 // Does not modify the state of the iterator.
 func (iterator *Iterator) Index() int {
+	if iterator.index == unknownIndex {
+		node := iterator.iterator.Node()
+		if node == nil {
+			return -1
+		}
+		iterator.index = iterator.tree.Rank(node.Key)
+	}
 	return iterator.index
 }
 
+// Clone returns an independent copy of the iterator at the same position, e.g. for lookahead or backtracking.
+// Moving either of the iterators does not move the other one.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Clone() Iterator {
+	return *iterator
+}
+
 // Begin resets the iterator to its initial state (one-before-first)
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator) Begin() {
 	iterator.index = -1
+	if iterator.bounded {
+		iterator.index = unknownIndex
+	}
 	iterator.iterator.Begin()
 }
 
@@ -68,6 +130,9 @@ func (iterator *Iterator) Begin() {
 // Call Prev() to fetch the last element if any.
 func (iterator *Iterator) End() {
 	iterator.index = iterator.tree.Size()
+	if iterator.bounded {
+		iterator.index = unknownIndex
+	}
 	iterator.iterator.End()
 }
 
@@ -114,3 +179,85 @@ func (iterator *Iterator) PrevTo(f func(index int, value interface{}) bool) bool
 	}
 	return false
 }
+
+// SeekGE moves the iterator to the first value greater than or equal to the given value and returns
+// true if there was such a value, otherwise moves the iterator past the last element.
+// Value should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekGE(value interface{}) bool {
+	return iterator.seek(iterator.iterator.SeekGE(value), iterator.tree.Size())
+}
+
+// SeekGT moves the iterator to the first value greater than the given value and returns
+// true if there was such a value, otherwise moves the iterator past the last element.
+// Value should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekGT(value interface{}) bool {
+	return iterator.seek(iterator.iterator.SeekGT(value), iterator.tree.Size())
+}
+
+// SeekLE moves the iterator to the last value smaller than or equal to the given value and returns
+// true if there was such a value, otherwise moves the iterator before the first element.
+// Value should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekLE(value interface{}) bool {
+	return iterator.seek(iterator.iterator.SeekLE(value), -1)
+}
+
+// SeekLT moves the iterator to the last value smaller than the given value and returns
+// true if there was such a value, otherwise moves the iterator before the first element.
+// Value should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekLT(value interface{}) bool {
+	return iterator.seek(iterator.iterator.SeekLT(value), -1)
+}
+
+// seek updates the index after seeking, which is outside if no value was found.
+func (iterator *Iterator) seek(found bool, outside int) bool {
+	iterator.index = unknownIndex
+	if !found && !iterator.bounded {
+		iterator.index = outside
+	}
+	return found
+}
+
+// Err returns containers.ErrConcurrentModification if the checked iterator stopped because the set was modified
+// during iteration, otherwise nil.
+func (iterator *Iterator) Err() error {
+	return iterator.iterator.Err()
+}
+
+// AllSeq returns an iterator over index-value pairs of the set in iteration order, for use with range-over-func.
+// It is not named All, which is the predicate of Enumerable.
+func (set *Set) AllSeq() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		iterator := set.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Index(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over values of the set in iteration order, for use with range-over-func.
+// It is not named Values, which returns the values as a slice.
+func (set *Set) ValuesSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		iterator := set.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over index-value pairs of the set in reverse iteration order, for use with range-over-func.
+func (set *Set) Backward() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		iterator := set.Iterator()
+		iterator.End()
+		for iterator.Prev() {
+			if !yield(iterator.Index(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
@@ -7,6 +7,7 @@ package treeset
 import (
 	"encoding/json"
 	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/utils"
 )
 
 // Assert Serialization implementation
@@ -19,14 +20,25 @@ func (set *Set) ToJSON() ([]byte, error) {
 }
 
 // FromJSON populates the set from the input JSON representation.
+// The elements are sorted and the tree is built from them directly, without rebalancing.
 func (set *Set) FromJSON(data []byte) error {
 	elements := []interface{}{}
 	err := json.Unmarshal(data, &elements)
-	if err == nil {
-		set.Clear()
-		set.Add(elements...)
+	if err != nil {
+		return err
 	}
-	return err
+	utils.Sort(elements, set.tree.Comparator)
+	items := elements[:0]
+	for _, element := range elements {
+		if len(items) == 0 || set.tree.Comparator(items[len(items)-1], element) != 0 {
+			items = append(items, element)
+		}
+	}
+	values := make([]interface{}, len(items))
+	for i := range values {
+		values[i] = itemExists
+	}
+	return set.tree.LoadSorted(items, values)
 }
 
 // UnmarshalJSON @implements json.Unmarshaler
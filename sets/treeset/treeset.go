@@ -14,6 +14,7 @@ import (
 	"github.com/uncle-gua/gods/sets"
 	rbt "github.com/uncle-gua/gods/trees/redblacktree"
 	"github.com/uncle-gua/gods/utils"
+	"math/bits"
 	"reflect"
 	"strings"
 )
@@ -101,6 +102,54 @@ func (set *Set) Values() []interface{} {
 	return set.tree.Keys()
 }
 
+// Rank returns the number of items in the set that are smaller than the given item in O(log n) time,
+// i.e. the index the item has or would have if it were added to the set.
+// Item should adhere to the comparator's type assertion, otherwise method panics.
+func (set *Set) Rank(item interface{}) int {
+	return set.tree.Rank(item)
+}
+
+// IndexOf returns the index of the item in the sorted sequence of items in O(log n) time,
+// or -1 if item is not found in set.
+// Item should adhere to the comparator's type assertion, otherwise method panics.
+func (set *Set) IndexOf(item interface{}) int {
+	return set.tree.IndexOf(item)
+}
+
+// GetAt returns the item at the given index in the sorted sequence of items in O(log n) time.
+// Second return parameter is true if index is within bounds, otherwise false.
+func (set *Set) GetAt(index int) (item interface{}, found bool) {
+	if node, found := set.tree.GetAt(index); found {
+		return node.Key, true
+	}
+	return nil, false
+}
+
+// CountRange returns the number of items in the half-open range [from, to) in O(log n) time.
+// Items should adhere to the comparator's type assertion, otherwise method panics.
+func (set *Set) CountRange(from, to interface{}) int {
+	return set.tree.CountRange(from, to)
+}
+
+// Split moves the items smaller than the item into the left set and all other items into the right set
+// in O(log n) time, leaving the set empty.
+// Item should adhere to the comparator's type assertion, otherwise method panics.
+func (set *Set) Split(item interface{}) (left *Set, right *Set) {
+	leftTree, rightTree := set.tree.Split(item)
+	return &Set{tree: leftTree}, &Set{tree: rightTree}
+}
+
+// Join moves the items of the left and the right set, which must all be smaller in the left set than in the
+// right set, into a new set with the left set's comparator in O(log n) time, leaving both sets empty.
+// Returns an error wrapping trees.ErrUnsorted if the items are not in order, in which case the sets are not modified.
+func Join(left *Set, right *Set) (*Set, error) {
+	tree, err := rbt.Join(left.tree, right.tree)
+	if err != nil {
+		return nil, err
+	}
+	return &Set{tree: tree}, nil
+}
+
 // String returns a string representation of container
 func (set *Set) String() string {
 	str := "TreeSet\n"
@@ -114,56 +163,46 @@ func (set *Set) String() string {
 
 // Intersection returns the intersection between two sets.
 // The new set consists of all elements that are both in "set" and "another".
+// It takes O(m*log(n)) time if the smaller set of size m is much smaller than the larger set of size n,
+// otherwise O(n+m) time.
 // The two sets should have the same comparators, otherwise the result is empty set.
 // Ref: https://en.wikipedia.org/wiki/Intersection_(set_theory)
 func (set *Set) Intersection(another *Set) *Set {
-	result := NewWith(set.tree.Comparator)
-
 	setComparator := reflect.ValueOf(set.tree.Comparator)
 	anotherComparator := reflect.ValueOf(another.tree.Comparator)
 	if setComparator.Pointer() != anotherComparator.Pointer() {
-		return result
+		return NewWith(set.tree.Comparator)
 	}
 
-	// Iterate over smaller set (optimization)
-	if set.Size() <= another.Size() {
-		for it := set.Iterator(); it.Next(); {
-			if another.Contains(it.Value()) {
-				result.Add(it.Value())
-			}
-		}
-	} else {
-		for it := another.Iterator(); it.Next(); {
-			if set.Contains(it.Value()) {
+	smaller, larger := set, another
+	if smaller.Size() > larger.Size() {
+		smaller, larger = larger, smaller
+	}
+	if smaller.Size()*bits.Len(uint(larger.Size())) < larger.Size() {
+		// Iterate over the much smaller set (optimization)
+		result := NewWith(set.tree.Comparator)
+		for it := smaller.Iterator(); it.Next(); {
+			if larger.Contains(it.Value()) {
 				result.Add(it.Value())
 			}
 		}
+		return result
 	}
-
-	return result
+	return &Set{tree: rbt.Intersection(set.copyTree(), another.copyTree())}
 }
 
-// Union returns the union of two sets.
+// Union returns the union of two sets in O(n+m) time, i.e. linear in the size of the new set.
 // The new set consists of all elements that are in "set" or "another" (possibly both).
 // The two sets should have the same comparators, otherwise the result is empty set.
 // Ref: https://en.wikipedia.org/wiki/Union_(set_theory)
 func (set *Set) Union(another *Set) *Set {
-	result := NewWith(set.tree.Comparator)
-
 	setComparator := reflect.ValueOf(set.tree.Comparator)
 	anotherComparator := reflect.ValueOf(another.tree.Comparator)
 	if setComparator.Pointer() != anotherComparator.Pointer() {
-		return result
-	}
-
-	for it := set.Iterator(); it.Next(); {
-		result.Add(it.Value())
-	}
-	for it := another.Iterator(); it.Next(); {
-		result.Add(it.Value())
+		return NewWith(set.tree.Comparator)
 	}
 
-	return result
+	return &Set{tree: rbt.Union(set.copyTree(), another.copyTree())}
 }
 
 // Difference returns the difference between two sets.
@@ -187,3 +226,9 @@ func (set *Set) Difference(another *Set) *Set {
 
 	return result
 }
+
+// copyTree returns a copy of the set's tree in O(n) time.
+func (set *Set) copyTree() *rbt.Tree {
+	tree, _ := rbt.NewFromSorted(set.tree.Comparator, set.tree.Keys(), set.tree.Values()) // the items are in order
+	return tree
+}
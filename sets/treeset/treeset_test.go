@@ -6,7 +6,14 @@ package treeset
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/lists/arraylist"
+	"github.com/uncle-gua/gods/maps/hashmap"
+	"github.com/uncle-gua/gods/trees"
+	"github.com/uncle-gua/gods/utils"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -80,6 +87,107 @@ func TestSetRemove(t *testing.T) {
 	}
 }
 
+func TestSetOrderStatistics(t *testing.T) {
+	set := NewWithIntComparator()
+	for _, key := range []int{12, 4, 20, 8, 16, 2, 10, 18, 6, 14} {
+		set.Add(key)
+	}
+	set.Remove(10)
+
+	tests1 := [][]interface{}{
+		{1, 0, -1},
+		{2, 0, 0},
+		{3, 1, -1},
+		{10, 4, -1},
+		{12, 4, 4},
+		{20, 8, 8},
+		{21, 9, -1},
+	}
+	for _, test := range tests1 {
+		if actualValue, expectedValue := set.Rank(test[0]), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := set.IndexOf(test[0]), test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	tests2 := [][]interface{}{
+		{-1, nil, false},
+		{0, 2, true},
+		{4, 12, true},
+		{8, 20, true},
+		{9, nil, false},
+	}
+	for _, test := range tests2 {
+		key, found := set.GetAt(test[0].(int))
+		if actualValue, expectedValue := found, test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := key, test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	tests3 := [][]interface{}{
+		{0, 100, 9},
+		{4, 14, 4},
+		{13, 15, 1},
+		{5, 5, 0},
+		{14, 4, 0},
+	}
+	for _, test := range tests3 {
+		if actualValue, expectedValue := set.CountRange(test[0], test[1]), test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestSetOrderStatisticsConsistency(t *testing.T) {
+	set := NewWithIntComparator()
+	for key := 0; key < 1000; key++ {
+		set.Add(key)
+	}
+	for key := 0; key < 1000; key += 3 {
+		set.Remove(key)
+	}
+	for index := 0; index < 666; index++ {
+		key, found := set.GetAt(index)
+		if !found || set.IndexOf(key) != index || set.Rank(key) != index {
+			t.Errorf("Got %v expected %v", set.IndexOf(key), index)
+		}
+	}
+}
+
+func TestSetSplitAndJoin(t *testing.T) {
+	set := NewWithStringComparator("c", "a", "e", "b", "d")
+
+	left, right := set.Split("c")
+	if actualValue, expectedValue := fmt.Sprintf("%v", left.Values()), "[a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", right.Values()), "[c d e]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.Empty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if _, err := Join(right, left); !errors.Is(err, trees.ErrUnsorted) {
+		t.Errorf("Got %v expected %v", err, trees.ErrUnsorted)
+	}
+	set, err := Join(left, right)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", set.Values()), "[a b c d e]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := left.Empty() && right.Empty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestSetEach(t *testing.T) {
 	set := NewWithStringComparator()
 	set.Add("c", "a", "b")
@@ -189,6 +297,112 @@ func TestSetFind(t *testing.T) {
 	}
 }
 
+func TestSetFold(t *testing.T) {
+	c := NewWithIntComparator(1, 2, 3, 4)
+	if actualValue, expectedValue := c.Fold(10, func(accumulator interface{}, index int, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}), 20; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := c.Reduce(func(accumulator interface{}, index int, value interface{}) interface{} {
+		return accumulator.(int) * value.(int)
+	}), 24; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	empty := NewWithIntComparator()
+	if actualValue, expectedValue := empty.Fold(10, func(accumulator interface{}, index int, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := empty.Reduce(func(accumulator interface{}, index int, value interface{}) interface{} {
+		return accumulator.(int) + value.(int)
+	}); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestSetCount(t *testing.T) {
+	c := NewWithIntComparator(1, 2, 3, 4)
+	if actualValue, expectedValue := c.Count(func(index int, value interface{}) bool {
+		return value.(int)%2 == 0
+	}), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetGroupBy(t *testing.T) {
+	c := NewWithIntComparator(1, 2, 3, 4)
+	groups := hashmap.New()
+	c.GroupBy(func(index int, value interface{}) interface{} {
+		return value.(int)%2 == 0
+	}, groups)
+	if actualValue, expectedValue := groups.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tests := [][]interface{}{
+		{true, "[2 4]"},
+		{false, "[1 3]"},
+	}
+	for _, test := range tests {
+		group, _ := groups.Get(test[0])
+		if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(group.(*arraylist.List), utils.IntComparator)), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestSetPartition(t *testing.T) {
+	c := NewWithIntComparator(1, 2, 3, 4)
+	selected, rest := c.Partition(func(index int, value interface{}) bool {
+		return value.(int)%2 == 0
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(selected, utils.IntComparator)), "[2 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(rest, utils.IntComparator)), "[1 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetMinByMaxBy(t *testing.T) {
+	c := NewWithIntComparator(1, 2, 3, 4)
+	if _, actualValue := c.MinBy(utils.IntComparator); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if _, actualValue := c.MaxBy(utils.IntComparator); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	empty := NewWithIntComparator()
+	if index, value := empty.MinBy(utils.IntComparator); index != -1 || value != nil {
+		t.Errorf("Got %v expected %v", value, nil)
+	}
+	if index, value := empty.MaxBy(utils.IntComparator); index != -1 || value != nil {
+		t.Errorf("Got %v expected %v", value, nil)
+	}
+}
+
+func TestSetSum(t *testing.T) {
+	c := NewWithIntComparator(1, 2, 3, 4)
+	if actualValue, expectedValue := c.SumInt(func(index int, value interface{}) int {
+		return value.(int)
+	}), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := c.SumFloat64(func(index int, value interface{}) float64 {
+		return float64(value.(int)) / 2
+	}), 5.0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetDistinct(t *testing.T) {
+	set := NewWithIntComparator(1, 2, 3, 4)
+	if actualValue, expectedValue := set.Distinct().Size(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestSetChaining(t *testing.T) {
 	set := NewWithStringComparator()
 	set.Add("c", "a", "b")
@@ -477,6 +691,18 @@ func TestSetSerialization(t *testing.T) {
 	if err != nil {
 		t.Errorf("Got error %v", err)
 	}
+
+	err = set.FromJSON([]byte(`["c","a","b","a"]`))
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", set.Values()), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.Add("d")
+	if actualValue, expectedValue := set.IndexOf("d"), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
 }
 
 func TestSetString(t *testing.T) {
@@ -520,6 +746,23 @@ func TestSetIntersection(t *testing.T) {
 	}
 }
 
+func TestSetIntersectionLopsided(t *testing.T) {
+	small := NewWithIntComparator(5, 500, 2000)
+	large := NewWithIntComparator()
+	for i := 0; i < 1000; i++ {
+		large.Add(i)
+	}
+	// the items of the small set are looked up in the large set, whichever is the receiver
+	for _, intersection := range []*Set{small.Intersection(large), large.Intersection(small)} {
+		if actualValue, expectedValue := fmt.Sprintf("%v", intersection.Values()), "[5 500]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := small.Size()+large.Size(), 1003; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestSetUnion(t *testing.T) {
 	{
 		set := NewWithStringComparator()
@@ -551,6 +794,9 @@ func TestSetUnion(t *testing.T) {
 	if actualValue := union.Contains("a", "b", "c", "d", "e", "f"); actualValue != true {
 		t.Errorf("Got %v expected %v", actualValue, true)
 	}
+	if actualValue, expectedValue := fmt.Sprintf("%v %v", set.Values(), another.Values()), "[a b c d] [c d e f]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
 }
 
 func TestSetDifference(t *testing.T) {
@@ -586,6 +832,255 @@ func TestSetDifference(t *testing.T) {
 	}
 }
 
+func TestSetIteratorConcurrentModification(t *testing.T) {
+	set := NewWithStringComparator("a", "b", "c")
+
+	// modifications before the first element are allowed
+	it := set.Iterator()
+	set.Add("d")
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, set.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// modifications during iteration panic
+	func() {
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		it := set.Iterator()
+		it.Next()
+		set.Remove("a")
+		it.Next()
+	}()
+
+	// checked iterator stops and reports the error
+	checked := set.CheckedIterator()
+	checked.Next()
+	set.Add("e")
+	if checked.Next() {
+		t.Errorf("Should not iterate after modification")
+	}
+	if actualValue, expectedValue := checked.Err(), containers.ErrConcurrentModification; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checked.Begin()
+	if actualValue := checked.Err(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	count = 0
+	for checked.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, set.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// modifications past the last element are allowed
+	it = set.Iterator()
+	it.End()
+	set.Add("f")
+	if actualValue, expectedValue := it.Prev(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if index, value := it.Index(), it.Value(); index != set.Size()-1 || value != "f" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, set.Size()-1, "f")
+	}
+}
+
+func TestSetIteratorClone(t *testing.T) {
+	set := NewWithStringComparator("a", "b", "c")
+
+	it := set.Iterator()
+	it.Next()
+	clone := it.Clone()
+	first := it.Value()
+	it.Next()
+	if actualValue, expectedValue := clone.Value(), first; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !clone.Next() || clone.Value() != it.Value() {
+		t.Errorf("Got %v expected %v", clone.Value(), it.Value())
+	}
+	clone.Next()
+	if clone.Next() {
+		t.Errorf("Should not go past the last element")
+	}
+	if !it.Next() {
+		t.Errorf("Should move independently of the clone")
+	}
+}
+
+func TestSetIteratorSeek(t *testing.T) {
+	set := NewWithIntComparator()
+	for i := 2; i <= 20; i += 2 {
+		set.Add(i)
+	}
+	it := set.Iterator()
+	tests := [][]interface{}{
+		{it.SeekGE, 4, true, 4},
+		{it.SeekGE, 5, true, 6},
+		{it.SeekGE, 0, true, 2},
+		{it.SeekGE, 21, false, nil},
+		{it.SeekGT, 4, true, 6},
+		{it.SeekGT, 20, false, nil},
+		{it.SeekLE, 4, true, 4},
+		{it.SeekLE, 5, true, 4},
+		{it.SeekLE, 1, false, nil},
+		{it.SeekLT, 4, true, 2},
+		{it.SeekLT, 100, true, 20},
+		{it.SeekLT, 2, false, nil},
+	}
+	for _, test := range tests {
+		found := test[0].(func(interface{}) bool)(test[1])
+		if actualValue, expectedValue := found, test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if found && it.Value() != test[3] {
+			t.Errorf("Got %v expected %v", it.Value(), test[3])
+		}
+	}
+
+}
+
+func TestSetIteratorSeekAndMove(t *testing.T) {
+	set := NewWithIntComparator()
+	for i := 2; i <= 20; i += 2 {
+		set.Add(i)
+	}
+	it := set.Iterator()
+
+	// iteration continues from the sought element in both directions
+	it.SeekGE(5)
+	if !it.Next() || it.Value() != 8 {
+		t.Errorf("Got %v expected %v", it.Value(), 8)
+	}
+	it.SeekGE(5)
+	if !it.Prev() || it.Value() != 4 {
+		t.Errorf("Got %v expected %v", it.Value(), 4)
+	}
+	it.SeekGT(20)
+	if !it.Prev() || it.Value() != 20 {
+		t.Errorf("Got %v expected %v", it.Value(), 20)
+	}
+	it.SeekLT(2)
+	if !it.Next() || it.Value() != 2 {
+		t.Errorf("Got %v expected %v", it.Value(), 2)
+	}
+	it.SeekLE(9)
+	if actualValue, expectedValue := it.Index(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.Next()
+	if actualValue, expectedValue := it.Index(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.SeekGT(20)
+	if actualValue, expectedValue := it.Index(), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.Prev()
+	if actualValue, expectedValue := it.Index(), 9; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetRangeIterator(t *testing.T) {
+	set := NewWithIntComparator()
+	for i := 2; i <= 20; i += 2 {
+		set.Add(i)
+	}
+	it := set.RangeIterator(5, 15)
+	values := []interface{}{}
+	for it.Next() {
+		values = append(values, it.Value())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", values), "[6 8 10 12 14]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	values = []interface{}{}
+	for it.Prev() {
+		values = append(values, it.Value())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", values), "[14 12 10 8 6]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tests := [][]interface{}{
+		{it.First, 6},
+		{it.Last, 14},
+		{func() bool { return it.SeekGE(1) }, 6},
+		{func() bool { return it.SeekGT(10) }, 12},
+		{func() bool { return it.SeekLE(100) }, 14},
+		{func() bool { return it.SeekLT(6) }, nil},
+		{func() bool { return it.SeekGE(15) }, nil},
+	}
+	for _, test := range tests {
+		found := test[0].(func() bool)()
+		if actualValue, expectedValue := found, test[1] != nil; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if found && it.Value() != test[1] {
+			t.Errorf("Got %v expected %v", it.Value(), test[1])
+		}
+	}
+
+	// indexes are within the whole set
+	it.First()
+	if actualValue, expectedValue := it.Index(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.Next()
+	if actualValue, expectedValue := it.Index(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.Last()
+	it.Prev()
+	if actualValue, expectedValue := it.Index(), 5; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetSeq(t *testing.T) {
+	set := NewWithStringComparator()
+	set.Add("c", "a", "b")
+
+	pairs := []string{}
+	for index, value := range set.AllSeq() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", index, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "0:a 1:b 2:c"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(set.ValuesSeq())), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	pairs = []string{}
+	for index, value := range set.Backward() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", index, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "2:c 1:b 0:a"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	count := 0
+	for index := range set.AllSeq() {
+		if index == 1 {
+			break
+		}
+		count++
+	}
+	if actualValue, expectedValue := count, 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func benchmarkContains(b *testing.B, set *Set, size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -21,7 +21,8 @@ var _ stacks.Stack = (*Stack)(nil)
 
 // Stack holds elements in an array-list
 type Stack struct {
-	list *arraylist.List
+	list     *arraylist.List
+	modCount int // number of structural modifications, checked by iterators
 }
 
 // New instantiates a new empty stack
@@ -31,6 +32,7 @@ func New() *Stack {
 
 // Push adds a value onto the top of the stack
 func (stack *Stack) Push(value interface{}) {
+	stack.modCount++
 	stack.list.Add(value)
 }
 
@@ -38,6 +40,9 @@ func (stack *Stack) Push(value interface{}) {
 // Second return parameter is true, unless the stack was empty and there was nothing to pop.
 func (stack *Stack) Pop() (value interface{}, ok bool) {
 	value, ok = stack.list.Get(stack.list.Size() - 1)
+	if ok {
+		stack.modCount++
+	}
 	stack.list.Remove(stack.list.Size() - 1)
 	return
 }
@@ -60,6 +65,7 @@ func (stack *Stack) Size() int {
 
 // Clear removes all elements from the stack.
 func (stack *Stack) Clear() {
+	stack.modCount++
 	stack.list.Clear()
 }
 
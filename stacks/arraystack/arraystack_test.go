@@ -7,6 +7,11 @@ package arraystack
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/lists/arraylist"
+	"github.com/uncle-gua/gods/maps/hashmap"
+	"github.com/uncle-gua/gods/utils"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -392,6 +397,305 @@ func TestStackString(t *testing.T) {
 	}
 }
 
+func TestStackIteratorConcurrentModification(t *testing.T) {
+	stack := New()
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+
+	// modifications before the first element are allowed
+	it := stack.Iterator()
+	stack.Push("d")
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, stack.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// modifications during iteration panic
+	func() {
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		it := stack.Iterator()
+		it.Next()
+		stack.Pop()
+		it.Next()
+	}()
+
+	// checked iterator stops and reports the error
+	checked := stack.CheckedIterator()
+	checked.Next()
+	stack.Push("e")
+	if checked.Next() {
+		t.Errorf("Should not iterate after modification")
+	}
+	if actualValue, expectedValue := checked.Err(), containers.ErrConcurrentModification; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checked.Begin()
+	if actualValue := checked.Err(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	count = 0
+	for checked.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, stack.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStackIteratorClone(t *testing.T) {
+	stack := New()
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+
+	it := stack.Iterator()
+	it.Next()
+	clone := it.Clone()
+	first := it.Value()
+	it.Next()
+	if actualValue, expectedValue := clone.Value(), first; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !clone.Next() || clone.Value() != it.Value() {
+		t.Errorf("Got %v expected %v", clone.Value(), it.Value())
+	}
+	clone.Next()
+	if clone.Next() {
+		t.Errorf("Should not go past the last element")
+	}
+	if !it.Next() {
+		t.Errorf("Should move independently of the clone")
+	}
+}
+
+func TestStackEach(t *testing.T) {
+	stack := New()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	values := []interface{}{}
+	stack.Each(func(index int, value interface{}) {
+		if actualValue, expectedValue := index, len(values); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		values = append(values, value)
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", values), "[3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStackMap(t *testing.T) {
+	stack := New()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	mapped := stack.Map(func(index int, value interface{}) interface{} {
+		return value.(int) * 10
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(mapped.ValuesSeq())), "[30 20 10]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(stack.ValuesSeq())), "[3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStackSelect(t *testing.T) {
+	stack := New()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	selected := stack.Select(func(index int, value interface{}) bool {
+		return value.(int) != 2
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(selected.ValuesSeq())), "[3 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := stack.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStackGroupBy(t *testing.T) {
+	stack := New()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	stack.Push(2)
+	groups := hashmap.New()
+	stack.GroupBy(func(index int, value interface{}) interface{} {
+		return value.(int)%2 == 0
+	}, groups)
+	if actualValue, expectedValue := groups.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tests := [][]interface{}{
+		{true, "[2 2]"},
+		{false, "[3 1]"},
+	}
+	for _, test := range tests {
+		group, _ := groups.Get(test[0])
+		if actualValue, expectedValue := fmt.Sprintf("%v", group.(*arraylist.List).Values()), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestStackPartition(t *testing.T) {
+	stack := New()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	stack.Push(2)
+	selected, rest := stack.Partition(func(index int, value interface{}) bool {
+		return value.(int)%2 == 0
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", selected.Values()), "[2 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", rest.Values()), "[3 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStackDistinct(t *testing.T) {
+	stack := New()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	stack.Push(2)
+	if actualValue, expectedValue := fmt.Sprintf("%v", stack.Distinct().Values()), "[2 3 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := stack.Size(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStackAnyAll(t *testing.T) {
+	stack := New()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	tests := [][]interface{}{
+		{stack.Any, 2, true},
+		{stack.Any, 3, false},
+		{stack.All, 0, true},
+		{stack.All, 1, false},
+	}
+	for _, test := range tests {
+		f, bound := test[0].(func(func(index int, value interface{}) bool) bool), test[1].(int)
+		if actualValue, expectedValue := f(func(index int, value interface{}) bool {
+			return value.(int) > bound
+		}), test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestStackFind(t *testing.T) {
+	stack := New()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	foundIndex, foundValue := stack.Find(func(index int, value interface{}) bool {
+		return value.(int) == 2
+	})
+	if foundIndex != 1 || foundValue != 2 {
+		t.Errorf("Got %v:%v expected %v:%v", foundIndex, foundValue, 1, 2)
+	}
+	foundIndex, foundValue = stack.Find(func(index int, value interface{}) bool {
+		return value.(int) == 4
+	})
+	if foundIndex != -1 || foundValue != nil {
+		t.Errorf("Got %v:%v expected %v:%v", foundIndex, foundValue, -1, nil)
+	}
+}
+
+func TestStackFold(t *testing.T) {
+	stack := New()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	tests := [][]interface{}{
+		{stack.Fold(0, func(accumulator interface{}, index int, value interface{}) interface{} {
+			return accumulator.(int) + value.(int)
+		}), 6},
+		{stack.Reduce(func(accumulator interface{}, index int, value interface{}) interface{} {
+			return accumulator.(int)*10 + value.(int)
+		}), 321},
+		{stack.Count(func(index int, value interface{}) bool {
+			return value.(int)%2 == 1
+		}), 2},
+		{stack.SumInt(func(index int, value interface{}) int {
+			return value.(int)
+		}), 6},
+		{stack.SumFloat64(func(index int, value interface{}) float64 {
+			return float64(value.(int)) / 2
+		}), 3.0},
+	}
+	for _, test := range tests {
+		if actualValue, expectedValue := test[0], test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if _, actualValue := stack.MinBy(utils.IntComparator); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if _, actualValue := stack.MaxBy(utils.IntComparator); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, expectedValue := stack.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStackSeq(t *testing.T) {
+	stack := New()
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+
+	pairs := []string{}
+	for index, value := range stack.AllSeq() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", index, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "0:c 1:b 2:a"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(stack.ValuesSeq())), "[c b a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	pairs = []string{}
+	for index, value := range stack.Backward() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", index, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "2:a 1:b 0:c"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	count := 0
+	for index := range stack.AllSeq() {
+		if index == 1 {
+			break
+		}
+		count++
+	}
+	if actualValue, expectedValue := count, 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func benchmarkPush(b *testing.B, stack *Stack, size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -0,0 +1,223 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraystack
+
+import (
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/lists/arraylist"
+	"github.com/uncle-gua/gods/utils"
+)
+
+// Assert Enumerable implementation
+var _ containers.EnumerableWithIndex = (*Stack)(nil)
+
+// Each calls the given function once for each element, passing that element's index and value.
+func (stack *Stack) Each(f func(index int, value interface{})) {
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		f(iterator.Index(), iterator.Value())
+	}
+}
+
+// Map invokes the given function once for each element and returns a
+// container containing the values returned by the given function.
+// The new stack holds the values in the same order as the stack, i.e. the first value is on its top.
+func (stack *Stack) Map(f func(index int, value interface{}) interface{}) *Stack {
+	values := []interface{}{}
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		values = append(values, f(iterator.Index(), iterator.Value()))
+	}
+	return newFromTop(values)
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+// The new stack holds the values in the same order as the stack, i.e. the first value is on its top.
+func (stack *Stack) Select(f func(index int, value interface{}) bool) *Stack {
+	values := []interface{}{}
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			values = append(values, iterator.Value())
+		}
+	}
+	return newFromTop(values)
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+func (stack *Stack) Any(f func(index int, value interface{}) bool) bool {
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+func (stack *Stack) All(f func(index int, value interface{}) bool) bool {
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		if !f(iterator.Index(), iterator.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (index,value) for which the function is true or -1,nil otherwise
+// if no element matches the criteria.
+func (stack *Stack) Find(f func(index int, value interface{}) bool) (int, interface{}) {
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return iterator.Index(), iterator.Value()
+		}
+	}
+	return -1, nil
+}
+
+// Fold passes each element of the container to the given function together with the accumulated value,
+// starting with the initial value, and returns the last value returned by the function (or the initial value).
+func (stack *Stack) Fold(initial interface{}, f func(accumulator interface{}, index int, value interface{}) interface{}) interface{} {
+	accumulator := initial
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		accumulator = f(accumulator, iterator.Index(), iterator.Value())
+	}
+	return accumulator
+}
+
+// Reduce works like Fold, starting with the first element's value as the accumulated value,
+// and returns nil if the container is empty.
+func (stack *Stack) Reduce(f func(accumulator interface{}, index int, value interface{}) interface{}) interface{} {
+	iterator := stack.Iterator()
+	if !iterator.Next() {
+		return nil
+	}
+	accumulator := iterator.Value()
+	for iterator.Next() {
+		accumulator = f(accumulator, iterator.Index(), iterator.Value())
+	}
+	return accumulator
+}
+
+// Count returns the number of elements for which the given function returns a true value.
+func (stack *Stack) Count(f func(index int, value interface{}) bool) int {
+	count := 0
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			count++
+		}
+	}
+	return count
+}
+
+// GroupBy puts each element into a group stored in the given map under the key returned by the given function.
+// Groups are new array lists of the values in iteration order, the map should not hold any other values.
+func (stack *Stack) GroupBy(f func(index int, value interface{}) interface{}, groups containers.Groups) {
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		key := f(iterator.Index(), iterator.Value())
+		group, found := groups.Get(key)
+		if !found {
+			group = arraylist.New()
+			groups.Put(key, group)
+		}
+		group.(*arraylist.List).Add(iterator.Value())
+	}
+}
+
+// Partition returns a new stack containing all elements for which the given function returns a true value
+// and a new stack containing the rest of the elements.
+// The new stacks hold the values in the same order as the stack, i.e. the first value is on their top.
+func (stack *Stack) Partition(f func(index int, value interface{}) bool) (containers.Container, containers.Container) {
+	selected, rest := []interface{}{}, []interface{}{}
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			selected = append(selected, iterator.Value())
+		} else {
+			rest = append(rest, iterator.Value())
+		}
+	}
+	return newFromTop(selected), newFromTop(rest)
+}
+
+// MinBy returns the first smallest (index,value) according to the given comparator of values
+// or -1,nil if the container is empty.
+func (stack *Stack) MinBy(comparator utils.Comparator) (int, interface{}) {
+	index, value := -1, interface{}(nil)
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		if index == -1 || comparator(iterator.Value(), value) < 0 {
+			index, value = iterator.Index(), iterator.Value()
+		}
+	}
+	return index, value
+}
+
+// MaxBy returns the first largest (index,value) according to the given comparator of values
+// or -1,nil if the container is empty.
+func (stack *Stack) MaxBy(comparator utils.Comparator) (int, interface{}) {
+	index, value := -1, interface{}(nil)
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		if index == -1 || comparator(iterator.Value(), value) > 0 {
+			index, value = iterator.Index(), iterator.Value()
+		}
+	}
+	return index, value
+}
+
+// SumInt returns the sum of the numbers returned by the given function for each element.
+func (stack *Stack) SumInt(f func(index int, value interface{}) int) int {
+	var sum int
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		sum += f(iterator.Index(), iterator.Value())
+	}
+	return sum
+}
+
+// SumFloat64 returns the sum of the numbers returned by the given function for each element.
+func (stack *Stack) SumFloat64(f func(index int, value interface{}) float64) float64 {
+	var sum float64
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		sum += f(iterator.Index(), iterator.Value())
+	}
+	return sum
+}
+
+// Distinct returns a new stack containing the elements without repeated values, keeping the first occurrence of each value.
+// The new stack holds the values in the same order as the stack, i.e. the first value is on its top.
+// Values must be comparable with == (i.e. usable as map keys), otherwise the method panics.
+func (stack *Stack) Distinct() containers.Container {
+	values := []interface{}{}
+	seen := make(map[interface{}]struct{})
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		if _, found := seen[iterator.Value()]; !found {
+			seen[iterator.Value()] = struct{}{}
+			values = append(values, iterator.Value())
+		}
+	}
+	return newFromTop(values)
+}
+
+// newFromTop instantiates a stack holding the values from its top to its bottom.
+func newFromTop(values []interface{}) *Stack {
+	stack := New()
+	for i := len(values) - 1; i >= 0; i-- {
+		stack.Push(values[i])
+	}
+	return stack
+}
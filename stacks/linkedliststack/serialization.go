@@ -19,6 +19,7 @@ func (stack *Stack) ToJSON() ([]byte, error) {
 
 // FromJSON populates the stack from the input JSON representation.
 func (stack *Stack) FromJSON(data []byte) error {
+	stack.modCount++
 	return stack.list.FromJSON(data)
 }
 
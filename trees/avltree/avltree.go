@@ -10,19 +10,23 @@
 package avltree
 
 import (
+	"bytes"
 	"fmt"
+	"github.com/uncle-gua/gods/containers"
 	"github.com/uncle-gua/gods/trees"
 	"github.com/uncle-gua/gods/utils"
 )
 
 // Assert Tree implementation
 var _ trees.Tree = new(Tree)
+var _ containers.Validator = new(Tree)
 
 // Tree holds elements of the AVL tree.
 type Tree struct {
 	Root       *Node            // Root node
 	Comparator utils.Comparator // Key comparator
 	size       int              // Total number of keys in the tree
+	modCount   int              // Number of structural modifications, checked by iterators
 }
 
 // Node is a single element within the tree
@@ -32,6 +36,7 @@ type Node struct {
 	Parent   *Node    // Parent node
 	Children [2]*Node // Children nodes
 	b        int8
+	size     int // Number of nodes in the subtree rooted at this node
 }
 
 // NewWith instantiates an AVL tree with the custom comparator.
@@ -101,19 +106,78 @@ func (t *Tree) Size() int {
 }
 
 // Size returns the number of elements stored in the subtree.
-// Computed dynamically on each call, i.e. the subtree is traversed to count the number of the nodes.
+// The size is maintained by the tree on every modification, so this takes constant time.
 func (n *Node) Size() int {
 	if n == nil {
 		return 0
 	}
-	size := 1
-	if n.Children[0] != nil {
-		size += n.Children[0].Size()
+	return n.size
+}
+
+// Rank returns the number of keys in the tree that are smaller than the given key in O(log n) time,
+// i.e. the index the key has or would have if it were put into the tree.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (t *Tree) Rank(key interface{}) int {
+	rank, _ := t.rank(key)
+	return rank
+}
+
+// IndexOf returns the index of the key in the in-order sequence of keys in O(log n) time,
+// or -1 if key is not found in tree.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (t *Tree) IndexOf(key interface{}) int {
+	if rank, found := t.rank(key); found {
+		return rank
+	}
+	return -1
+}
+
+// GetAt returns the node at the given index in the in-order sequence of nodes in O(log n) time.
+// Second return parameter is true if index is within bounds, otherwise false.
+func (t *Tree) GetAt(index int) (node *Node, found bool) {
+	if index < 0 || index >= t.size {
+		return nil, false
+	}
+	n := t.Root
+	for {
+		left := n.Children[0].Size()
+		switch {
+		case index < left:
+			n = n.Children[0]
+		case index == left:
+			return n, true
+		default:
+			index -= left + 1
+			n = n.Children[1]
+		}
+	}
+}
+
+// CountRange returns the number of keys in the half-open range [from, to) in O(log n) time.
+// Keys should adhere to the comparator's type assertion, otherwise method panics.
+func (t *Tree) CountRange(from, to interface{}) int {
+	if t.Comparator(from, to) >= 0 {
+		return 0
 	}
-	if n.Children[1] != nil {
-		size += n.Children[1].Size()
+	return t.Rank(to) - t.Rank(from)
+}
+
+// rank returns the number of keys smaller than the given key and whether the key is in the tree.
+func (t *Tree) rank(key interface{}) (rank int, found bool) {
+	n := t.Root
+	for n != nil {
+		c := t.Comparator(key, n.Key)
+		switch {
+		case c == 0:
+			return rank + n.Children[0].Size(), true
+		case c < 0:
+			n = n.Children[0]
+		case c > 0:
+			rank += n.Children[0].Size() + 1
+			n = n.Children[1]
+		}
 	}
-	return size
+	return rank, false
 }
 
 // Keys returns all keys in-order
@@ -206,10 +270,98 @@ func (t *Tree) Ceiling(key interface{}) (floor *Node, found bool) {
 	return nil, false
 }
 
+// ceilingNode returns the smallest node whose key is greater than (or, if inclusive, equal to) the given key,
+// or nil if there is no such node.
+func (t *Tree) ceilingNode(key interface{}, inclusive bool) *Node {
+	var ceiling *Node
+	n := t.Root
+	for n != nil {
+		c := t.Comparator(key, n.Key)
+		if c < 0 || c == 0 && inclusive {
+			ceiling = n
+			n = n.Children[0]
+		} else {
+			n = n.Children[1]
+		}
+	}
+	return ceiling
+}
+
+// floorNode returns the largest node whose key is smaller than (or, if inclusive, equal to) the given key,
+// or nil if there is no such node.
+func (t *Tree) floorNode(key interface{}, inclusive bool) *Node {
+	var floor *Node
+	n := t.Root
+	for n != nil {
+		c := t.Comparator(key, n.Key)
+		if c > 0 || c == 0 && inclusive {
+			floor = n
+			n = n.Children[1]
+		} else {
+			n = n.Children[0]
+		}
+	}
+	return floor
+}
+
 // Clear removes all nodes from the tree.
 func (t *Tree) Clear() {
 	t.Root = nil
 	t.size = 0
+	t.modCount++
+}
+
+// Split moves the nodes with keys smaller than the key into the left tree and all other nodes into the right tree
+// in O(log n) time, leaving the tree empty.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (t *Tree) Split(key interface{}) (left *Tree, right *Tree) {
+	l, _, n, r, rh := t.split(t.Root, t.Root.height(), key)
+	if n != nil {
+		r, _ = join(nil, 0, n, r, rh)
+	}
+	left, right = t.from(l), t.from(r)
+	t.Clear()
+	return left, right
+}
+
+// Join moves the nodes of the left and the right tree, whose keys must all be smaller in the left tree than in the
+// right tree, into a new tree with the left tree's comparator in O(log n) time, leaving both trees empty.
+// Returns an error wrapping trees.ErrUnsorted if the keys are not in order, in which case the trees are not modified.
+func Join(left *Tree, right *Tree) (*Tree, error) {
+	if !left.Empty() && !right.Empty() {
+		if max, min := left.Right(), right.Left(); left.Comparator(max.Key, min.Key) >= 0 {
+			return nil, fmt.Errorf("%w: left key %v is not smaller than right key %v", trees.ErrUnsorted, max.Key, min.Key)
+		}
+	}
+	n, _ := join2(left.Root, left.Root.height(), right.Root, right.Root.height())
+	t := left.from(n)
+	left.Clear()
+	right.Clear()
+	return t, nil
+}
+
+// Union moves the nodes of both trees into a new tree with the first tree's comparator, leaving both trees empty.
+// The value in the first tree is kept for keys in both trees.
+// It takes O(m*log(n/m+1)) time, where m is the size of the smaller tree and n the size of the larger tree.
+// Both trees should have the same comparator.
+func Union(a *Tree, b *Tree) *Tree {
+	n, _ := a.union(a.Root, a.Root.height(), b.Root, b.Root.height())
+	t := a.from(n)
+	a.Clear()
+	b.Clear()
+	return t
+}
+
+// Intersection moves the nodes of the first tree whose keys are also in the second tree into a new tree with the
+// first tree's comparator, leaving both trees empty.
+// It takes O(m*log(n/m+1)) time, where m is the size of the smaller tree and n the size of the larger tree.
+// Both trees should have the same comparator.
+func Intersection(a *Tree, b *Tree) *Tree {
+	n, _ := a.intersection(a.Root, a.Root.height(), b.Root, b.Root.height())
+	t := a.from(n)
+	a.Clear()
+	b.Clear()
+	return t
 }
 
 // String returns a string representation of container
@@ -221,6 +373,93 @@ func (t *Tree) String() string {
 	return str
 }
 
+// Validate checks that the heights of the subtrees of every node differ by at most one and match its balance
+// factor, that the keys are in strictly increasing order, and that the parent links and subtree sizes are consistent.
+// Returns an error wrapping containers.ErrInvalid, which describes the first violated rule and the node where it was
+// violated, or nil if the tree is valid.
+func (t *Tree) Validate() error {
+	if t.Root != nil && t.Root.Parent != nil {
+		return fmt.Errorf("%w: root %v has parent %v", containers.ErrInvalid, t.Root, t.Root.Parent)
+	}
+	var previous *Node
+	if _, err := t.validate(t.Root, &previous); err != nil {
+		return err
+	}
+	if size := t.Root.Size(); t.size != size {
+		return fmt.Errorf("%w: tree has size %d instead of %d", containers.ErrInvalid, t.size, size)
+	}
+	return nil
+}
+
+// validate checks the subtree of the node, whose in-order predecessor is previous, and returns its height,
+// see Validate. Sets previous to the last node of the subtree.
+func (t *Tree) validate(n *Node, previous **Node) (height int, err error) {
+	if n == nil {
+		return 0, nil
+	}
+	for _, c := range n.Children {
+		if c != nil && c.Parent != n {
+			return 0, fmt.Errorf("%w: node %v does not link to its parent %v", containers.ErrInvalid, c, n)
+		}
+	}
+	left, err := t.validate(n.Children[0], previous)
+	if err != nil {
+		return 0, err
+	}
+	if *previous != nil && t.Comparator((*previous).Key, n.Key) >= 0 {
+		return 0, fmt.Errorf("%w: node %v is not greater than its predecessor %v", containers.ErrInvalid, n, *previous)
+	}
+	*previous = n
+	right, err := t.validate(n.Children[1], previous)
+	if err != nil {
+		return 0, err
+	}
+	if err := validateBalance(n, left, right); err != nil {
+		return 0, err
+	}
+	return max(left, right) + 1, nil
+}
+
+// validateBalance checks the balance factor and the size of the node, whose subtrees have the given heights.
+func validateBalance(n *Node, left, right int) error {
+	if right-left < -1 || right-left > 1 {
+		return fmt.Errorf("%w: node %v is unbalanced with height %d on the left and %d on the right", containers.ErrInvalid, n, left, right)
+	}
+	if int(n.b) != right-left {
+		return fmt.Errorf("%w: node %v has balance factor %d instead of %d", containers.ErrInvalid, n, n.b, right-left)
+	}
+	if size := n.Children[0].Size() + n.Children[1].Size() + 1; n.size != size {
+		return fmt.Errorf("%w: node %v has size %d instead of %d", containers.ErrInvalid, n, n.size, size)
+	}
+	return nil
+}
+
+// ToDOT returns the tree in the Graphviz DOT language, with the balance factor of each node below its key.
+// The missing child of a node with a single child is drawn as an invisible node, which keeps the other child on its side.
+func (t *Tree) ToDOT() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("digraph AVLTree {\n")
+	buffer.WriteString("\tgraph [ordering=out];\n")
+	if !t.Empty() {
+		id := 0
+		dot(&buffer, t.Root, &id)
+	}
+	buffer.WriteString("}\n")
+	return buffer.String()
+}
+
+// ToMermaid returns the tree as a Mermaid flowchart, with the balance factor of each node below its key.
+// The edge to the child of a node with a single child is labeled with the child's side.
+func (t *Tree) ToMermaid() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("graph TD\n")
+	if !t.Empty() {
+		id := 0
+		mermaid(&buffer, t.Root, &id)
+	}
+	return buffer.String()
+}
+
 func (n *Node) String() string {
 	return fmt.Sprintf("%v", n.Key)
 }
@@ -229,7 +468,8 @@ func (t *Tree) put(key interface{}, value interface{}, p *Node, qp **Node) bool
 	q := *qp
 	if q == nil {
 		t.size++
-		*qp = &Node{Key: key, Value: value, Parent: p}
+		t.modCount++
+		*qp = &Node{Key: key, Value: value, Parent: p, size: 1}
 		return true
 	}
 
@@ -248,6 +488,7 @@ func (t *Tree) put(key interface{}, value interface{}, p *Node, qp **Node) bool
 	a := (c + 1) / 2
 	var fix bool
 	fix = t.put(key, value, q, &q.Children[a])
+	q.updateSize()
 	if fix {
 		return putFix(int8(c), qp)
 	}
@@ -263,6 +504,7 @@ func (t *Tree) remove(key interface{}, qp **Node) bool {
 	c := t.Comparator(key, q.Key)
 	if c == 0 {
 		t.size--
+		t.modCount++
 		if q.Children[1] == nil {
 			if q.Children[0] != nil {
 				q.Children[0].Parent = q.Parent
@@ -271,6 +513,7 @@ func (t *Tree) remove(key interface{}, qp **Node) bool {
 			return true
 		}
 		fix := removeMin(&q.Children[1], &q.Key, &q.Value)
+		q.updateSize()
 		if fix {
 			return removeFix(-1, qp)
 		}
@@ -284,6 +527,7 @@ func (t *Tree) remove(key interface{}, qp **Node) bool {
 	}
 	a := (c + 1) / 2
 	fix := t.remove(key, &q.Children[a])
+	q.updateSize()
 	if fix {
 		return removeFix(int8(-c), qp)
 	}
@@ -302,6 +546,7 @@ func removeMin(qp **Node, minKey *interface{}, minVal *interface{}) bool {
 		return true
 	}
 	fix := removeMin(&q.Children[0], minKey, minVal)
+	q.updateSize()
 	if fix {
 		return removeFix(1, qp)
 	}
@@ -397,9 +642,192 @@ func rotate(c int8, s *Node) *Node {
 	r.Children[a^1] = s
 	r.Parent = s.Parent
 	s.Parent = r
+	r.size = s.size
+	s.updateSize()
 	return r
 }
 
+// updateSize recomputes the size of the node from the sizes of its children.
+func (n *Node) updateSize() {
+	n.size = 1 + n.Children[0].Size() + n.Children[1].Size()
+}
+
+// from returns a tree with the root and the tree's comparator.
+func (t *Tree) from(root *Node) *Tree {
+	return &Tree{Root: root, Comparator: t.Comparator, size: root.Size()}
+}
+
+// height returns the height of the subtree by following its taller children.
+func (n *Node) height() int {
+	h := 0
+	for ; n != nil; h++ {
+		if n.b < 0 {
+			n = n.Children[0]
+		} else {
+			n = n.Children[1]
+		}
+	}
+	return h
+}
+
+// childHeights returns the heights of the children of the node of the height.
+func (n *Node) childHeights(h int) (int, int) {
+	switch {
+	case n.b < 0:
+		return h - 1, h - 2
+	case n.b > 0:
+		return h - 2, h - 1
+	}
+	return h - 1, h - 1
+}
+
+// detach removes the link from the node to its parent and returns the node.
+func (n *Node) detach() *Node {
+	if n != nil {
+		n.Parent = nil
+	}
+	return n
+}
+
+// The functions below implement the join-based algorithms on subtrees, whose heights are passed along,
+// so that joining takes time proportional to the difference of the heights, and splitting telescopes to O(log n).
+// The subtrees are detached from their parents.
+// Reference: https://en.wikipedia.org/wiki/Join-based_tree_algorithms
+
+// join returns the root of a subtree of the left subtree, the node and the right subtree, whose keys are in order,
+// and its height.
+func join(l *Node, lh int, n *Node, r *Node, rh int) (*Node, int) {
+	n.Parent = nil
+	switch {
+	case lh > rh+1:
+		if joinInto(1, &l, lh, n, r, rh) {
+			lh++
+		}
+		return l, lh
+	case rh > lh+1:
+		if joinInto(-1, &r, rh, n, l, lh) {
+			rh++
+		}
+		return r, rh
+	}
+	n.Children = [2]*Node{l, r}
+	n.link()
+	n.b = int8(rh - lh)
+	if lh > rh {
+		return n, lh + 1
+	}
+	return n, rh + 1
+}
+
+// joinInto puts the node with the subtree s on the outer side c of the subtree *qp, which is taller by more than
+// one than s, and returns whether the height of *qp grew.
+func joinInto(c int8, qp **Node, h int, n *Node, s *Node, sh int) bool {
+	q := *qp
+	a := (c + 1) / 2
+	ch := h - 1
+	if q.b == -c {
+		ch--
+	}
+	if ch > sh+1 {
+		fix := joinInto(c, &q.Children[a], ch, n, s, sh)
+		q.updateSize()
+		if fix {
+			return putFix(c, qp)
+		}
+		return false
+	}
+	n.Children[a^1], n.Children[a] = q.Children[a], s
+	n.link()
+	n.b = c * int8(sh-ch)
+	n.Parent = q
+	q.Children[a] = n
+	q.updateSize()
+	return putFix(c, qp)
+}
+
+// link sets the node as the parent of its children and updates its size.
+func (n *Node) link() {
+	for _, child := range n.Children {
+		if child != nil {
+			child.Parent = n
+		}
+	}
+	n.updateSize()
+}
+
+// join2 returns the root of a subtree of the left and the right subtree, whose keys are in order, and its height.
+func join2(l *Node, lh int, r *Node, rh int) (*Node, int) {
+	if l == nil {
+		return r, rh
+	}
+	l, lh, last := splitLast(l, lh)
+	return join(l, lh, last, r, rh)
+}
+
+// split returns the subtrees of the keys smaller and greater than the key with their heights and the node of
+// the key, if any, of the subtree.
+func (t *Tree) split(n *Node, h int, key interface{}) (l *Node, lh int, found *Node, r *Node, rh int) {
+	if n == nil {
+		return nil, 0, nil, nil, 0
+	}
+	nlh, nrh := n.childHeights(h)
+	nl, nr := n.Children[0].detach(), n.Children[1].detach()
+	c := t.Comparator(key, n.Key)
+	switch {
+	case c < 0:
+		l, lh, found, r, rh = t.split(nl, nlh, key)
+		r, rh = join(r, rh, n, nr, nrh)
+		return l, lh, found, r, rh
+	case c > 0:
+		l, lh, found, r, rh = t.split(nr, nrh, key)
+		l, lh = join(nl, nlh, n, l, lh)
+		return l, lh, found, r, rh
+	}
+	return nl, nlh, n, nr, nrh
+}
+
+// splitLast returns the subtree without its last node with its height and the last node.
+func splitLast(n *Node, h int) (rest *Node, restHeight int, last *Node) {
+	nlh, nrh := n.childHeights(h)
+	if n.Children[1] == nil {
+		return n.Children[0].detach(), nlh, n
+	}
+	rest, restHeight, last = splitLast(n.Children[1].detach(), nrh)
+	rest, restHeight = join(n.Children[0].detach(), nlh, n, rest, restHeight)
+	return rest, restHeight, last
+}
+
+// union returns the root of a subtree of the union of the subtrees and its height.
+func (t *Tree) union(a *Node, ah int, b *Node, bh int) (*Node, int) {
+	if a == nil {
+		return b, bh
+	}
+	if b == nil {
+		return a, ah
+	}
+	alh, arh := a.childHeights(ah)
+	bl, blh, _, br, brh := t.split(b, bh, a.Key)
+	l, lh := t.union(a.Children[0].detach(), alh, bl, blh)
+	r, rh := t.union(a.Children[1].detach(), arh, br, brh)
+	return join(l, lh, a, r, rh)
+}
+
+// intersection returns the root of a subtree of the nodes of the subtree a whose keys are in the subtree b and
+// its height.
+func (t *Tree) intersection(a *Node, ah int, b *Node, bh int) (*Node, int) {
+	if a == nil || b == nil {
+		return nil, 0
+	}
+	alh, arh := a.childHeights(ah)
+	bl, blh, found, br, brh := t.split(b, bh, a.Key)
+	l, lh := t.intersection(a.Children[0].detach(), alh, bl, blh)
+	r, rh := t.intersection(a.Children[1].detach(), arh, br, brh)
+	if found == nil {
+		return join2(l, lh, r, rh)
+	}
+	return join(l, lh, a, r, rh)
+}
+
 func (t *Tree) bottom(d int) *Node {
 	n := t.Root
 	if n == nil {
@@ -445,6 +873,38 @@ func (n *Node) walk1(a int) *Node {
 	return p
 }
 
+func dot(buffer *bytes.Buffer, n *Node, id *int) {
+	name := fmt.Sprintf("n%d", *id)
+	*id++
+	fmt.Fprintf(buffer, "\t%s [label=\"%s\"];\n", name, trees.EscapeDOT(fmt.Sprintf("%v\nb=%d", n, n.b)))
+	for _, c := range n.Children {
+		if c != nil {
+			fmt.Fprintf(buffer, "\t%s -> n%d;\n", name, *id)
+			dot(buffer, c, id)
+		} else if n.Children[0] != nil || n.Children[1] != nil {
+			fmt.Fprintf(buffer, "\t%s -> n%d [style=invis];\n\tn%d [style=invis];\n", name, *id, *id)
+			*id++
+		}
+	}
+}
+
+func mermaid(buffer *bytes.Buffer, n *Node, id *int) {
+	name := fmt.Sprintf("n%d", *id)
+	*id++
+	fmt.Fprintf(buffer, "\t%s[\"%s\"]\n", name, trees.EscapeMermaid(fmt.Sprintf("%v\nb=%d", n, n.b)))
+	for side, c := range n.Children {
+		if c == nil {
+			continue
+		}
+		edge := "-->"
+		if n.Children[0] == nil || n.Children[1] == nil {
+			edge = []string{"-->|left|", "-->|right|"}[side]
+		}
+		fmt.Fprintf(buffer, "\t%s %s n%d\n", name, edge, *id)
+		mermaid(buffer, c, id)
+	}
+}
+
 func output(node *Node, prefix string, isTail bool, str *string) {
 	if node.Children[1] != nil {
 		newPrefix := prefix
@@ -12,7 +12,9 @@
 package binaryheap
 
 import (
+	"bytes"
 	"fmt"
+	"github.com/uncle-gua/gods/containers"
 	"github.com/uncle-gua/gods/lists/arraylist"
 	"github.com/uncle-gua/gods/trees"
 	"github.com/uncle-gua/gods/utils"
@@ -21,11 +23,13 @@ import (
 
 // Assert Tree implementation
 var _ trees.Tree = (*Heap)(nil)
+var _ containers.Validator = (*Heap)(nil)
 
 // Heap holds elements in an array-list
 type Heap struct {
 	list       *arraylist.List
 	Comparator utils.Comparator
+	modCount   int // number of structural modifications, checked by iterators
 }
 
 // NewWith instantiates a new empty heap tree with the custom comparator.
@@ -45,6 +49,7 @@ func NewWithStringComparator() *Heap {
 
 // Push adds a value onto the heap and bubbles it up accordingly.
 func (heap *Heap) Push(values ...interface{}) {
+	heap.modCount++
 	if len(values) == 1 {
 		heap.list.Add(values[0])
 		heap.bubbleUp()
@@ -67,6 +72,7 @@ func (heap *Heap) Pop() (value interface{}, ok bool) {
 	if !ok {
 		return
 	}
+	heap.modCount++
 	lastIndex := heap.list.Size() - 1
 	heap.list.Swap(0, lastIndex)
 	heap.list.Remove(lastIndex)
@@ -92,6 +98,7 @@ func (heap *Heap) Size() int {
 
 // Clear removes all elements from the heap.
 func (heap *Heap) Clear() {
+	heap.modCount++
 	heap.list.Clear()
 }
 
@@ -115,6 +122,51 @@ func (heap *Heap) String() string {
 	return str
 }
 
+// Validate checks the heap property, i.e. that no element is smaller than its parent with respect to the comparator.
+// Returns an error wrapping containers.ErrInvalid, which describes the first violation and the element where it
+// occurred, or nil if the heap is valid.
+func (heap *Heap) Validate() error {
+	for index := 1; index < heap.list.Size(); index++ {
+		parentIndex := (index - 1) >> 1
+		value, _ := heap.list.Get(index)
+		parent, _ := heap.list.Get(parentIndex)
+		if heap.Comparator(value, parent) < 0 {
+			return fmt.Errorf("%w: element %v at index %d is smaller than its parent %v at index %d", containers.ErrInvalid, value, index, parent, parentIndex)
+		}
+	}
+	return nil
+}
+
+// ToDOT returns the heap in the Graphviz DOT language as the binary tree it represents, where node n<i> holds the
+// element at index i.
+func (heap *Heap) ToDOT() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("digraph BinaryHeap {\n")
+	buffer.WriteString("\tgraph [ordering=out];\n")
+	for index, value := range heap.list.Values() {
+		if index > 0 {
+			fmt.Fprintf(&buffer, "\tn%d -> n%d;\n", (index-1)>>1, index)
+		}
+		fmt.Fprintf(&buffer, "\tn%d [label=\"%s\"];\n", index, trees.EscapeDOT(fmt.Sprintf("%v", value)))
+	}
+	buffer.WriteString("}\n")
+	return buffer.String()
+}
+
+// ToMermaid returns the heap as a Mermaid flowchart of the binary tree it represents, where node n<i> holds the
+// element at index i.
+func (heap *Heap) ToMermaid() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("graph TD\n")
+	for index, value := range heap.list.Values() {
+		if index > 0 {
+			fmt.Fprintf(&buffer, "\tn%d --> n%d\n", (index-1)>>1, index)
+		}
+		fmt.Fprintf(&buffer, "\tn%d[\"%s\"]\n", index, trees.EscapeMermaid(fmt.Sprintf("%v", value)))
+	}
+	return buffer.String()
+}
+
 // Performs the "bubble down" operation. This is to place the element that is at the root
 // of the heap in its correct place so that the heap maintains the min/max-heap order property.
 func (heap *Heap) bubbleDown() {
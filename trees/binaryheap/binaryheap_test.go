@@ -6,7 +6,14 @@ package binaryheap
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/lists/arraylist"
+	"github.com/uncle-gua/gods/maps/hashmap"
+	"github.com/uncle-gua/gods/utils"
 	"math/rand"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -417,6 +424,304 @@ func TestBTreeString(t *testing.T) {
 	}
 }
 
+func TestBinaryHeapIteratorConcurrentModification(t *testing.T) {
+	heap := NewWithStringComparator()
+	heap.Push("a", "b", "c")
+
+	// modifications before the first element are allowed
+	it := heap.Iterator()
+	heap.Push("d")
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, heap.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// modifications during iteration panic
+	func() {
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		it := heap.Iterator()
+		it.Next()
+		heap.Pop()
+		it.Next()
+	}()
+
+	// checked iterator stops and reports the error
+	checked := heap.CheckedIterator()
+	checked.Next()
+	heap.Push("e")
+	if checked.Next() {
+		t.Errorf("Should not iterate after modification")
+	}
+	if actualValue, expectedValue := checked.Err(), containers.ErrConcurrentModification; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checked.Begin()
+	if actualValue := checked.Err(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	count = 0
+	for checked.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, heap.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryHeapIteratorClone(t *testing.T) {
+	heap := NewWithStringComparator()
+	heap.Push("a", "b", "c")
+
+	it := heap.Iterator()
+	it.Next()
+	clone := it.Clone()
+	first := it.Value()
+	it.Next()
+	if actualValue, expectedValue := clone.Value(), first; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !clone.Next() || clone.Value() != it.Value() {
+		t.Errorf("Got %v expected %v", clone.Value(), it.Value())
+	}
+	clone.Next()
+	if clone.Next() {
+		t.Errorf("Should not go past the last element")
+	}
+	if !it.Next() {
+		t.Errorf("Should move independently of the clone")
+	}
+}
+
+func TestBinaryHeapEach(t *testing.T) {
+	heap := NewWithIntComparator()
+	heap.Push(3, 1, 2)
+	values := []interface{}{}
+	heap.Each(func(index int, value interface{}) {
+		if actualValue, expectedValue := index, len(values); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		values = append(values, value)
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", values), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryHeapMap(t *testing.T) {
+	heap := NewWithIntComparator()
+	heap.Push(3, 1, 2)
+	mapped := heap.Map(func(index int, value interface{}) interface{} {
+		return value.(int) * 10
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(mapped.ValuesSeq())), "[10 20 30]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(heap.ValuesSeq())), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryHeapMapWith(t *testing.T) {
+	heap := NewWithIntComparator()
+	heap.Push(3, 1, 2)
+	// the values change their type, so that they are ordered by a comparator of the new type
+	mapped := heap.MapWith(utils.StringComparator, func(index int, value interface{}) interface{} {
+		return strings.Repeat("a", 4-value.(int))
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(mapped.ValuesSeq())), "[a aa aaa]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(heap.ValuesSeq())), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryHeapSelect(t *testing.T) {
+	heap := NewWithIntComparator()
+	heap.Push(3, 1, 2)
+	selected := heap.Select(func(index int, value interface{}) bool {
+		return value.(int) != 2
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(selected.ValuesSeq())), "[1 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := heap.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryHeapGroupBy(t *testing.T) {
+	heap := NewWithIntComparator()
+	heap.Push(3)
+	heap.Push(1)
+	heap.Push(2)
+	heap.Push(1)
+	groups := hashmap.New()
+	heap.GroupBy(func(index int, value interface{}) interface{} {
+		return value.(int)%2 == 0
+	}, groups)
+	if actualValue, expectedValue := groups.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tests := [][]interface{}{
+		{true, "[2]"},
+		{false, "[1 1 3]"},
+	}
+	for _, test := range tests {
+		group, _ := groups.Get(test[0])
+		if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(group.(*arraylist.List), utils.IntComparator)), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestBinaryHeapPartition(t *testing.T) {
+	heap := NewWithIntComparator()
+	heap.Push(3)
+	heap.Push(1)
+	heap.Push(2)
+	heap.Push(1)
+	selected, rest := heap.Partition(func(index int, value interface{}) bool {
+		return value.(int)%2 == 0
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(selected, utils.IntComparator)), "[2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(rest, utils.IntComparator)), "[1 1 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryHeapDistinct(t *testing.T) {
+	heap := NewWithIntComparator()
+	heap.Push(3)
+	heap.Push(1)
+	heap.Push(2)
+	heap.Push(1)
+	if actualValue, expectedValue := fmt.Sprintf("%v", containers.GetSortedValues(heap.Distinct(), utils.IntComparator)), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := heap.Size(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryHeapAnyAll(t *testing.T) {
+	heap := NewWithIntComparator()
+	heap.Push(3, 1, 2)
+	tests := [][]interface{}{
+		{heap.Any, 2, true},
+		{heap.Any, 3, false},
+		{heap.All, 0, true},
+		{heap.All, 1, false},
+	}
+	for _, test := range tests {
+		f, bound := test[0].(func(func(index int, value interface{}) bool) bool), test[1].(int)
+		if actualValue, expectedValue := f(func(index int, value interface{}) bool {
+			return value.(int) > bound
+		}), test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestBinaryHeapFind(t *testing.T) {
+	heap := NewWithIntComparator()
+	heap.Push(3, 1, 2)
+	foundIndex, foundValue := heap.Find(func(index int, value interface{}) bool {
+		return value.(int) == 2
+	})
+	if foundIndex != 1 || foundValue != 2 {
+		t.Errorf("Got %v:%v expected %v:%v", foundIndex, foundValue, 1, 2)
+	}
+	foundIndex, foundValue = heap.Find(func(index int, value interface{}) bool {
+		return value.(int) == 4
+	})
+	if foundIndex != -1 || foundValue != nil {
+		t.Errorf("Got %v:%v expected %v:%v", foundIndex, foundValue, -1, nil)
+	}
+}
+
+func TestBinaryHeapFold(t *testing.T) {
+	heap := NewWithIntComparator()
+	heap.Push(3, 1, 2)
+	tests := [][]interface{}{
+		{heap.Fold(0, func(accumulator interface{}, index int, value interface{}) interface{} {
+			return accumulator.(int) + value.(int)
+		}), 6},
+		{heap.Reduce(func(accumulator interface{}, index int, value interface{}) interface{} {
+			return accumulator.(int)*10 + value.(int)
+		}), 123},
+		{heap.Count(func(index int, value interface{}) bool {
+			return value.(int)%2 == 1
+		}), 2},
+		{heap.SumInt(func(index int, value interface{}) int {
+			return value.(int)
+		}), 6},
+		{heap.SumFloat64(func(index int, value interface{}) float64 {
+			return float64(value.(int)) / 2
+		}), 3.0},
+	}
+	for _, test := range tests {
+		if actualValue, expectedValue := test[0], test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if _, actualValue := heap.MinBy(utils.IntComparator); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if _, actualValue := heap.MaxBy(utils.IntComparator); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, expectedValue := heap.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryHeapSeq(t *testing.T) {
+	heap := NewWithStringComparator()
+	heap.Push("c")
+	heap.Push("a")
+	heap.Push("b")
+
+	pairs := []string{}
+	for index, value := range heap.AllSeq() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", index, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "0:a 1:b 2:c"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(heap.ValuesSeq())), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	pairs = []string{}
+	for index, value := range heap.Backward() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", index, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "2:c 1:b 0:a"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	count := 0
+	for index := range heap.AllSeq() {
+		if index == 1 {
+			break
+		}
+		count++
+	}
+	if actualValue, expectedValue := count, 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func benchmarkPush(b *testing.B, heap *Heap, size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -517,3 +822,71 @@ func BenchmarkBinaryHeapPush100000(b *testing.B) {
 	b.StartTimer()
 	benchmarkPush(b, heap, size)
 }
+
+func TestBinaryHeapValidate(t *testing.T) {
+	tests := [][]interface{}{
+		{func(heap *Heap) {}, ""},
+		{func(heap *Heap) { heap.list.Set(4, 0) }, "element 0 at index 4 is smaller than its parent 3 at index 1"},
+		{func(heap *Heap) { heap.list.Swap(0, 2) }, "element 1 at index 2 is smaller than its parent 2 at index 0"},
+	}
+	for _, test := range tests {
+		heap := NewWithIntComparator()
+		for _, value := range []int{3, 2, 1, 5, 4} {
+			heap.Push(value)
+		}
+		test[0].(func(*Heap))(heap)
+		err := heap.Validate()
+		if test[1] == "" {
+			if err != nil {
+				t.Errorf("Got %v expected %v", err, nil)
+			}
+			continue
+		}
+		if !errors.Is(err, containers.ErrInvalid) {
+			t.Errorf("Got %v expected %v", err, containers.ErrInvalid)
+		}
+		if err != nil && !strings.HasSuffix(err.Error(), test[1].(string)) {
+			t.Errorf("Got %v expected %v", err, test[1])
+		}
+	}
+}
+
+func TestBinaryHeapToDOT(t *testing.T) {
+	heap := NewWithStringComparator()
+	if actualValue, expectedValue := heap.ToDOT(), "digraph BinaryHeap {\n\tgraph [ordering=out];\n}\n"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	heap.Push("c", "b", `"a"`, "d")
+	expectedValue := "digraph BinaryHeap {\n" +
+		"\tgraph [ordering=out];\n" +
+		"\tn0 [label=\"\\\"a\\\"\"];\n" +
+		"\tn0 -> n1;\n" +
+		"\tn1 [label=\"b\"];\n" +
+		"\tn0 -> n2;\n" +
+		"\tn2 [label=\"c\"];\n" +
+		"\tn1 -> n3;\n" +
+		"\tn3 [label=\"d\"];\n" +
+		"}\n"
+	if actualValue := heap.ToDOT(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryHeapToMermaid(t *testing.T) {
+	heap := NewWithStringComparator()
+	if actualValue, expectedValue := heap.ToMermaid(), "graph TD\n"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	heap.Push("c", "b", `"a"`, "d")
+	expectedValue := "graph TD\n" +
+		"\tn0[\"#quot;a#quot;\"]\n" +
+		"\tn0 --> n1\n" +
+		"\tn1[\"b\"]\n" +
+		"\tn0 --> n2\n" +
+		"\tn2[\"c\"]\n" +
+		"\tn1 --> n3\n" +
+		"\tn3[\"d\"]\n"
+	if actualValue := heap.ToMermaid(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
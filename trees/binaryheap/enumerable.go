@@ -0,0 +1,221 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package binaryheap
+
+import (
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/lists/arraylist"
+	rbt "github.com/uncle-gua/gods/trees/redblacktree"
+	"github.com/uncle-gua/gods/utils"
+)
+
+// Assert Enumerable implementation
+var _ containers.EnumerableWithIndex = (*Heap)(nil)
+
+// Each calls the given function once for each element, passing that element's index and value.
+func (heap *Heap) Each(f func(index int, value interface{})) {
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		f(iterator.Index(), iterator.Value())
+	}
+}
+
+// Map invokes the given function once for each element and returns a
+// container containing the values returned by the given function.
+// The new heap orders the values by the comparator of the heap, so that the function has to return values of the
+// type of the elements, otherwise the comparator's type assertion panics. Use MapWith for functions returning values
+// of another type.
+func (heap *Heap) Map(f func(index int, value interface{}) interface{}) *Heap {
+	return heap.MapWith(heap.Comparator, f)
+}
+
+// MapWith invokes the given function once for each element and returns a
+// container containing the values returned by the given function,
+// which the new heap orders by the given comparator.
+func (heap *Heap) MapWith(comparator utils.Comparator, f func(index int, value interface{}) interface{}) *Heap {
+	newHeap := NewWith(comparator)
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		newHeap.Push(f(iterator.Index(), iterator.Value()))
+	}
+	return newHeap
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+func (heap *Heap) Select(f func(index int, value interface{}) bool) *Heap {
+	newHeap := NewWith(heap.Comparator)
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			newHeap.Push(iterator.Value())
+		}
+	}
+	return newHeap
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+func (heap *Heap) Any(f func(index int, value interface{}) bool) bool {
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+func (heap *Heap) All(f func(index int, value interface{}) bool) bool {
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		if !f(iterator.Index(), iterator.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (index,value) for which the function is true or -1,nil otherwise
+// if no element matches the criteria.
+func (heap *Heap) Find(f func(index int, value interface{}) bool) (int, interface{}) {
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return iterator.Index(), iterator.Value()
+		}
+	}
+	return -1, nil
+}
+
+// Fold passes each element of the container to the given function together with the accumulated value,
+// starting with the initial value, and returns the last value returned by the function (or the initial value).
+func (heap *Heap) Fold(initial interface{}, f func(accumulator interface{}, index int, value interface{}) interface{}) interface{} {
+	accumulator := initial
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		accumulator = f(accumulator, iterator.Index(), iterator.Value())
+	}
+	return accumulator
+}
+
+// Reduce works like Fold, starting with the first element's value as the accumulated value,
+// and returns nil if the container is empty.
+func (heap *Heap) Reduce(f func(accumulator interface{}, index int, value interface{}) interface{}) interface{} {
+	iterator := heap.Iterator()
+	if !iterator.Next() {
+		return nil
+	}
+	accumulator := iterator.Value()
+	for iterator.Next() {
+		accumulator = f(accumulator, iterator.Index(), iterator.Value())
+	}
+	return accumulator
+}
+
+// Count returns the number of elements for which the given function returns a true value.
+func (heap *Heap) Count(f func(index int, value interface{}) bool) int {
+	count := 0
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			count++
+		}
+	}
+	return count
+}
+
+// GroupBy puts each element into a group stored in the given map under the key returned by the given function.
+// Groups are new array lists of the values in iteration order, the map should not hold any other values.
+func (heap *Heap) GroupBy(f func(index int, value interface{}) interface{}, groups containers.Groups) {
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		key := f(iterator.Index(), iterator.Value())
+		group, found := groups.Get(key)
+		if !found {
+			group = arraylist.New()
+			groups.Put(key, group)
+		}
+		group.(*arraylist.List).Add(iterator.Value())
+	}
+}
+
+// Partition returns a new heap containing all elements for which the given function returns a true value
+// and a new heap containing the rest of the elements.
+func (heap *Heap) Partition(f func(index int, value interface{}) bool) (containers.Container, containers.Container) {
+	selected, rest := NewWith(heap.Comparator), NewWith(heap.Comparator)
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			selected.Push(iterator.Value())
+		} else {
+			rest.Push(iterator.Value())
+		}
+	}
+	return selected, rest
+}
+
+// MinBy returns the first smallest (index,value) according to the given comparator of values
+// or -1,nil if the container is empty.
+func (heap *Heap) MinBy(comparator utils.Comparator) (int, interface{}) {
+	index, value := -1, interface{}(nil)
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		if index == -1 || comparator(iterator.Value(), value) < 0 {
+			index, value = iterator.Index(), iterator.Value()
+		}
+	}
+	return index, value
+}
+
+// MaxBy returns the first largest (index,value) according to the given comparator of values
+// or -1,nil if the container is empty.
+func (heap *Heap) MaxBy(comparator utils.Comparator) (int, interface{}) {
+	index, value := -1, interface{}(nil)
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		if index == -1 || comparator(iterator.Value(), value) > 0 {
+			index, value = iterator.Index(), iterator.Value()
+		}
+	}
+	return index, value
+}
+
+// SumInt returns the sum of the numbers returned by the given function for each element.
+func (heap *Heap) SumInt(f func(index int, value interface{}) int) int {
+	var sum int
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		sum += f(iterator.Index(), iterator.Value())
+	}
+	return sum
+}
+
+// SumFloat64 returns the sum of the numbers returned by the given function for each element.
+func (heap *Heap) SumFloat64(f func(index int, value interface{}) float64) float64 {
+	var sum float64
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		sum += f(iterator.Index(), iterator.Value())
+	}
+	return sum
+}
+
+// Distinct returns a new heap containing the elements without repeated values, keeping the first occurrence of each value.
+// Values are repeated if the comparator of the heap considers them equal.
+func (heap *Heap) Distinct() containers.Container {
+	newHeap := NewWith(heap.Comparator)
+	seen := rbt.NewWith(heap.Comparator)
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		if _, found := seen.Get(iterator.Value()); !found {
+			seen.Put(iterator.Value(), nil)
+			newHeap.Push(iterator.Value())
+		}
+	}
+	return newHeap
+}
@@ -5,6 +5,8 @@
 package binaryheap
 
 import (
+	"iter"
+
 	"github.com/uncle-gua/gods/containers"
 )
 
@@ -13,13 +15,25 @@ var _ containers.ReverseIteratorWithIndex = (*Iterator)(nil)
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
 type Iterator struct {
-	heap  *Heap
-	index int
+	heap     *Heap
+	index    int
+	failFast containers.FailFast
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
+// The iterator fails fast: moving it after the heap was structurally modified other than through the iterator
+// panics with containers.ErrConcurrentModification. Modifications made while the iterator is in its initial
+// (one-before-first) state, or before it is reset by Begin() or End(), are allowed.
 func (heap *Heap) Iterator() Iterator {
-	return Iterator{heap: heap, index: -1}
+	return Iterator{heap: heap, index: -1, failFast: containers.NewFailFast(heap.modCount)}
+}
+
+// CheckedIterator returns a stateful iterator like Iterator(), except that instead of panicking when the heap was
+// modified during iteration, it stops and reports containers.ErrConcurrentModification by Err().
+func (heap *Heap) CheckedIterator() Iterator {
+	iterator := heap.Iterator()
+	iterator.failFast.Check()
+	return iterator
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -27,6 +41,9 @@ func (heap *Heap) Iterator() Iterator {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator) Next() bool {
+	if !iterator.failFast.Move(iterator.heap.modCount, iterator.index == -1) {
+		return false
+	}
 	if iterator.index < iterator.heap.Size() {
 		iterator.index++
 	}
@@ -37,6 +54,9 @@ func (iterator *Iterator) Next() bool {
 // If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator) Prev() bool {
+	if iterator.modified() {
+		return false
+	}
 	if iterator.index >= 0 {
 		iterator.index--
 	}
@@ -68,16 +88,25 @@ func (iterator *Iterator) Index() int {
 	return iterator.index
 }
 
+// Clone returns an independent copy of the iterator at the same position, e.g. for lookahead or backtracking.
+// Moving either of the iterators does not move the other one.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Clone() Iterator {
+	return *iterator
+}
+
 // Begin resets the iterator to its initial state (one-before-first)
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator) Begin() {
 	iterator.index = -1
+	iterator.sync()
 }
 
 // End moves the iterator past the last element (one-past-the-end).
 // Call Prev() to fetch the last element if any.
 func (iterator *Iterator) End() {
 	iterator.index = iterator.heap.Size()
+	iterator.sync()
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -141,3 +170,59 @@ func evaluateRange(index int) (start int, end int) {
 	end = start + 1<<bits
 	return
 }
+
+// Err returns containers.ErrConcurrentModification if the checked iterator stopped because the heap was modified
+// during iteration, otherwise nil.
+func (iterator *Iterator) Err() error {
+	return iterator.failFast.Err()
+}
+
+// sync accepts the current state of the heap, i.e. after the iterator was reset.
+func (iterator *Iterator) sync() {
+	iterator.failFast.Sync(iterator.heap.modCount)
+}
+
+// modified returns true if the heap was modified since the iterator was synchronised with it.
+// Panics with containers.ErrConcurrentModification, unless the iterator is checked.
+func (iterator *Iterator) modified() bool {
+	return iterator.failFast.Modified(iterator.heap.modCount)
+}
+
+// AllSeq returns an iterator over index-value pairs of the heap in iteration order, for use with range-over-func.
+// It is not named All, which is the predicate of Enumerable.
+func (heap *Heap) AllSeq() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		iterator := heap.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Index(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over values of the heap in iteration order, for use with range-over-func.
+// It is not named Values, which returns the values as a slice.
+func (heap *Heap) ValuesSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		iterator := heap.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over index-value pairs of the heap in reverse iteration order, for use with range-over-func.
+func (heap *Heap) Backward() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		iterator := heap.Iterator()
+		iterator.End()
+		for iterator.Prev() {
+			if !yield(iterator.Index(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
@@ -19,6 +19,7 @@ func (heap *Heap) ToJSON() ([]byte, error) {
 
 // FromJSON populates the heap from the input JSON representation.
 func (heap *Heap) FromJSON(data []byte) error {
+	heap.modCount++
 	return heap.list.FromJSON(data)
 }
 
@@ -0,0 +1,555 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package bplustree implements a B+ tree.
+//
+// A B+ tree of order m is a B-tree whose entries are all stored in the leaves, while the internal nodes only hold
+// copies of keys to guide the search:
+// - Every internal node has at most m children and every leaf at most m−1 entries.
+// - Every internal node (except root) has at least ⌈m/2⌉ children and every leaf (except root) at least ⌊m/2⌋ entries.
+// - The root has at least two children if it is not a leaf.
+// - An internal node with k children contains k−1 keys, the i-th of which is not greater than all keys in
+// the (i+1)-th subtree and greater than all keys in the i-th subtree.
+// - All leaves appear in the same level and are linked to their neighbouring leaves in order.
+//
+// Since the leaves are linked, iterating over the entries, e.g. scanning a range of keys, moves from entry to entry
+// and from leaf to leaf in constant time without climbing the tree.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/B%2B_tree
+package bplustree
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/uncle-gua/gods/trees"
+	"github.com/uncle-gua/gods/utils"
+	"strings"
+)
+
+// Assert Tree implementation
+var _ trees.Tree = (*Tree)(nil)
+
+// Tree holds elements of the B+ tree
+type Tree struct {
+	Root       *Node            // Root node
+	Comparator utils.Comparator // Key comparator
+	size       int              // Total number of keys in the tree
+	m          int              // order (maximum number of children)
+	modCount   int              // number of structural modifications, checked by iterators
+}
+
+// Node is a single element within the tree, either an internal node or a leaf
+type Node struct {
+	Keys     []interface{} // Keys separating the children of an internal node
+	Children []*Node       // Children nodes of an internal node
+	Entries  []*Entry      // Entries of a leaf
+	Prev     *Node         // Previous leaf of a leaf
+	Next     *Node         // Next leaf of a leaf
+}
+
+// Entry represents the key-value pair contained within leaves
+type Entry struct {
+	Key   interface{}
+	Value interface{}
+}
+
+// NewWith instantiates a B+ tree with the order (maximum number of children) and a custom key comparator.
+func NewWith(order int, comparator utils.Comparator) *Tree {
+	if order < 3 {
+		panic("Invalid order, should be at least 3")
+	}
+	return &Tree{m: order, Comparator: comparator}
+}
+
+// NewWithIntComparator instantiates a B+ tree with the order (maximum number of children) and the IntComparator, i.e. keys are of type int.
+func NewWithIntComparator(order int) *Tree {
+	return NewWith(order, utils.IntComparator)
+}
+
+// NewWithStringComparator instantiates a B+ tree with the order (maximum number of children) and the StringComparator, i.e. keys are of type string.
+func NewWithStringComparator(order int) *Tree {
+	return NewWith(order, utils.StringComparator)
+}
+
+// Put inserts key-value pair node into the tree.
+// If key already exists, then its value is updated with the new value.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Put(key interface{}, value interface{}) {
+	entry := &Entry{Key: key, Value: value}
+
+	if tree.Root == nil {
+		// Assert key is of comparator's type for initial tree
+		tree.Comparator(key, key)
+		tree.Root = &Node{Entries: []*Entry{entry}}
+		tree.size++
+		tree.modCount++
+		return
+	}
+
+	right, separator, inserted := tree.insert(tree.Root, entry)
+	if right != nil {
+		tree.Root = &Node{Keys: []interface{}{separator}, Children: []*Node{tree.Root, right}}
+	}
+	if inserted {
+		tree.size++
+		tree.modCount++
+	}
+}
+
+// Get searches the node in the tree by key and returns its value or nil if key is not found in tree.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Get(key interface{}) (value interface{}, found bool) {
+	if leaf := tree.GetNode(key); leaf != nil {
+		index, _ := tree.search(leaf, key)
+		return leaf.Entries[index].Value, true
+	}
+	return nil, false
+}
+
+// GetNode searches the leaf in the tree by key and returns the leaf holding the key or nil if key is not found in tree.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) GetNode(key interface{}) *Node {
+	leaf := tree.leaf(key)
+	if leaf == nil {
+		return nil
+	}
+	if _, found := tree.search(leaf, key); !found {
+		return nil
+	}
+	return leaf
+}
+
+// Remove remove the node from the tree by key.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Remove(key interface{}) {
+	if tree.Root == nil || !tree.delete(tree.Root, key) {
+		return
+	}
+	if tree.isLeaf(tree.Root) {
+		if len(tree.Root.Entries) == 0 {
+			tree.Root = nil
+		}
+	} else if len(tree.Root.Children) == 1 {
+		tree.Root = tree.Root.Children[0]
+	}
+	tree.size--
+	tree.modCount++
+}
+
+// Empty returns true if tree does not contain any nodes
+func (tree *Tree) Empty() bool {
+	return tree.size == 0
+}
+
+// Size returns number of nodes in the tree.
+func (tree *Tree) Size() int {
+	return tree.size
+}
+
+// Size returns the number of entries stored in the subtree.
+// Computed dynamically on each call, i.e. the subtree is traversed to count the number of the entries.
+func (node *Node) Size() int {
+	if node == nil {
+		return 0
+	}
+	size := len(node.Entries)
+	for _, child := range node.Children {
+		size += child.Size()
+	}
+	return size
+}
+
+// Keys returns all keys in-order
+func (tree *Tree) Keys() []interface{} {
+	keys := make([]interface{}, 0, tree.size)
+	for leaf := tree.Left(); leaf != nil; leaf = leaf.Next {
+		for _, entry := range leaf.Entries {
+			keys = append(keys, entry.Key)
+		}
+	}
+	return keys
+}
+
+// Values returns all values in-order based on the key.
+func (tree *Tree) Values() []interface{} {
+	values := make([]interface{}, 0, tree.size)
+	for leaf := tree.Left(); leaf != nil; leaf = leaf.Next {
+		for _, entry := range leaf.Entries {
+			values = append(values, entry.Value)
+		}
+	}
+	return values
+}
+
+// Clear removes all nodes from the tree.
+func (tree *Tree) Clear() {
+	tree.Root = nil
+	tree.size = 0
+	tree.modCount++
+}
+
+// Height returns the height of the tree.
+func (tree *Tree) Height() int {
+	height := 0
+	for node := tree.Root; node != nil; node = node.child(0) {
+		height++
+	}
+	return height
+}
+
+// Left returns the left-most (min) leaf or nil if tree is empty.
+func (tree *Tree) Left() *Node {
+	node := tree.Root
+	for node != nil && !tree.isLeaf(node) {
+		node = node.Children[0]
+	}
+	return node
+}
+
+// LeftKey returns the left-most (min) key or nil if tree is empty.
+func (tree *Tree) LeftKey() interface{} {
+	if left := tree.Left(); left != nil {
+		return left.Entries[0].Key
+	}
+	return nil
+}
+
+// LeftValue returns the left-most value or nil if tree is empty.
+func (tree *Tree) LeftValue() interface{} {
+	if left := tree.Left(); left != nil {
+		return left.Entries[0].Value
+	}
+	return nil
+}
+
+// Right returns the right-most (max) leaf or nil if tree is empty.
+func (tree *Tree) Right() *Node {
+	node := tree.Root
+	for node != nil && !tree.isLeaf(node) {
+		node = node.Children[len(node.Children)-1]
+	}
+	return node
+}
+
+// RightKey returns the right-most (max) key or nil if tree is empty.
+func (tree *Tree) RightKey() interface{} {
+	if right := tree.Right(); right != nil {
+		return right.Entries[len(right.Entries)-1].Key
+	}
+	return nil
+}
+
+// RightValue returns the right-most value or nil if tree is empty.
+func (tree *Tree) RightValue() interface{} {
+	if right := tree.Right(); right != nil {
+		return right.Entries[len(right.Entries)-1].Value
+	}
+	return nil
+}
+
+// String returns a string representation of container (for debugging purposes)
+func (tree *Tree) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("BPlusTree\n")
+	if !tree.Empty() {
+		tree.output(&buffer, tree.Root, 0)
+	}
+	return buffer.String()
+}
+
+func (entry *Entry) String() string {
+	return fmt.Sprintf("%v", entry.Key)
+}
+
+func (tree *Tree) output(buffer *bytes.Buffer, node *Node, level int) {
+	for _, entry := range node.Entries {
+		buffer.WriteString(strings.Repeat("    ", level))
+		buffer.WriteString(entry.String() + "\n")
+	}
+	for i, child := range node.Children {
+		tree.output(buffer, child, level+1)
+		if i < len(node.Keys) {
+			buffer.WriteString(strings.Repeat("    ", level))
+			buffer.WriteString(fmt.Sprintf("%v", node.Keys[i]) + "\n")
+		}
+	}
+}
+
+func (node *Node) child(index int) *Node {
+	if index < len(node.Children) {
+		return node.Children[index]
+	}
+	return nil
+}
+
+func (tree *Tree) isLeaf(node *Node) bool {
+	return len(node.Children) == 0
+}
+
+func (tree *Tree) maxChildren() int {
+	return tree.m
+}
+
+func (tree *Tree) minChildren() int {
+	return (tree.m + 1) / 2 // ceil(m/2)
+}
+
+func (tree *Tree) maxEntries() int {
+	return tree.m - 1
+}
+
+func (tree *Tree) minEntries() int {
+	return tree.m / 2 // floor(m/2), so that two leaves merged after an underflow are not too full
+}
+
+// search searches only within the single leaf among its entries
+func (tree *Tree) search(leaf *Node, key interface{}) (index int, found bool) {
+	low, high := 0, len(leaf.Entries)-1
+	var mid int
+	for low <= high {
+		mid = (high + low) / 2
+		compare := tree.Comparator(key, leaf.Entries[mid].Key)
+		switch {
+		case compare > 0:
+			low = mid + 1
+		case compare < 0:
+			high = mid - 1
+		case compare == 0:
+			return mid, true
+		}
+	}
+	return low, false
+}
+
+// childIndex returns the index of the child of the internal node whose subtree holds the key, if any.
+func (tree *Tree) childIndex(node *Node, key interface{}) int {
+	low, high := 0, len(node.Keys)-1
+	for low <= high {
+		mid := (high + low) / 2
+		if tree.Comparator(key, node.Keys[mid]) < 0 {
+			high = mid - 1
+		} else {
+			low = mid + 1
+		}
+	}
+	return low
+}
+
+// leaf returns the leaf whose range of keys holds the key, or nil if the tree is empty.
+func (tree *Tree) leaf(key interface{}) *Node {
+	node := tree.Root
+	for node != nil && !tree.isLeaf(node) {
+		node = node.Children[tree.childIndex(node, key)]
+	}
+	return node
+}
+
+// ceilingEntry returns the leaf and the index of the smallest entry whose key is greater than (or, if inclusive,
+// equal to) the given key, or nil if there is no such entry.
+func (tree *Tree) ceilingEntry(key interface{}, inclusive bool) (*Node, int) {
+	leaf := tree.leaf(key)
+	if leaf == nil {
+		return nil, 0
+	}
+	index, found := tree.search(leaf, key)
+	if found && !inclusive {
+		index++
+	}
+	if index == len(leaf.Entries) {
+		return leaf.Next, 0
+	}
+	return leaf, index
+}
+
+// floorEntry returns the leaf and the index of the largest entry whose key is smaller than (or, if inclusive,
+// equal to) the given key, or nil if there is no such entry.
+func (tree *Tree) floorEntry(key interface{}, inclusive bool) (*Node, int) {
+	leaf := tree.leaf(key)
+	if leaf == nil {
+		return nil, 0
+	}
+	index, found := tree.search(leaf, key)
+	if !found || !inclusive {
+		index--
+	}
+	if index < 0 {
+		if leaf = leaf.Prev; leaf == nil {
+			return nil, 0
+		}
+		return leaf, len(leaf.Entries) - 1
+	}
+	return leaf, index
+}
+
+// insert inserts the entry into the subtree of the node and returns whether it was inserted.
+// If the node was split, the new right node and the smallest key in its subtree are returned as well.
+func (tree *Tree) insert(node *Node, entry *Entry) (right *Node, key interface{}, inserted bool) {
+	if tree.isLeaf(node) {
+		index, found := tree.search(node, entry.Key)
+		if found {
+			node.Entries[index] = entry
+			return nil, nil, false
+		}
+		node.Entries = append(node.Entries, nil)
+		copy(node.Entries[index+1:], node.Entries[index:])
+		node.Entries[index] = entry
+		if len(node.Entries) > tree.maxEntries() {
+			right = tree.splitLeaf(node)
+			return right, right.Entries[0].Key, true
+		}
+		return nil, nil, true
+	}
+
+	index := tree.childIndex(node, entry.Key)
+	right, key, inserted = tree.insert(node.Children[index], entry)
+	if right == nil {
+		return nil, nil, inserted
+	}
+	node.Keys = append(node.Keys, nil)
+	copy(node.Keys[index+1:], node.Keys[index:])
+	node.Keys[index] = key
+	node.Children = append(node.Children, nil)
+	copy(node.Children[index+2:], node.Children[index+1:])
+	node.Children[index+1] = right
+	if len(node.Children) > tree.maxChildren() {
+		right, key = tree.splitInternal(node)
+		return right, key, inserted
+	}
+	return nil, nil, inserted
+}
+
+// splitLeaf moves the upper half of the entries of the leaf into a new leaf, which is linked after it, and returns it.
+func (tree *Tree) splitLeaf(leaf *Node) *Node {
+	middle := len(leaf.Entries) / 2
+	right := &Node{Entries: append([]*Entry(nil), leaf.Entries[middle:]...), Prev: leaf, Next: leaf.Next}
+	leaf.Entries = leaf.Entries[:middle]
+	if leaf.Next != nil {
+		leaf.Next.Prev = right
+	}
+	leaf.Next = right
+	return right
+}
+
+// splitInternal moves the upper half of the children of the internal node into a new node and returns it with the key
+// separating them, which is removed from the node.
+func (tree *Tree) splitInternal(node *Node) (*Node, interface{}) {
+	middle := len(node.Children) / 2
+	key := node.Keys[middle-1]
+	right := &Node{
+		Keys:     append([]interface{}(nil), node.Keys[middle:]...),
+		Children: append([]*Node(nil), node.Children[middle:]...),
+	}
+	node.Keys = node.Keys[:middle-1]
+	node.Children = node.Children[:middle]
+	return right, key
+}
+
+// delete deletes the key from the subtree of the node and returns whether it was deleted.
+// The node may underflow, which is fixed by its parent.
+func (tree *Tree) delete(node *Node, key interface{}) bool {
+	if tree.isLeaf(node) {
+		index, found := tree.search(node, key)
+		if !found {
+			return false
+		}
+		node.Entries = append(node.Entries[:index], node.Entries[index+1:]...)
+		return true
+	}
+
+	index := tree.childIndex(node, key)
+	if !tree.delete(node.Children[index], key) {
+		return false
+	}
+	child := node.Children[index]
+	if tree.isLeaf(child) && len(child.Entries) < tree.minEntries() ||
+		!tree.isLeaf(child) && len(child.Children) < tree.minChildren() {
+		tree.rebalance(node, index)
+	}
+	return true
+}
+
+// rebalance fixes the underflow of the child at the index of the internal node by borrowing from or merging with
+// a sibling of the child.
+func (tree *Tree) rebalance(node *Node, index int) {
+	child := node.Children[index]
+	var left, right *Node
+	if index > 0 {
+		left = node.Children[index-1]
+	}
+	if index+1 < len(node.Children) {
+		right = node.Children[index+1]
+	}
+
+	if tree.isLeaf(child) {
+		switch {
+		case left != nil && len(left.Entries) > tree.minEntries():
+			// borrow the last entry of the left sibling
+			entry := left.Entries[len(left.Entries)-1]
+			left.Entries = left.Entries[:len(left.Entries)-1]
+			child.Entries = append([]*Entry{entry}, child.Entries...)
+			node.Keys[index-1] = entry.Key
+		case right != nil && len(right.Entries) > tree.minEntries():
+			// borrow the first entry of the right sibling
+			child.Entries = append(child.Entries, right.Entries[0])
+			right.Entries = append(right.Entries[:0], right.Entries[1:]...)
+			node.Keys[index] = right.Entries[0].Key
+		case left != nil:
+			tree.mergeLeaves(node, index-1)
+		default:
+			tree.mergeLeaves(node, index)
+		}
+		return
+	}
+
+	switch {
+	case left != nil && len(left.Children) > tree.minChildren():
+		// rotate the last child of the left sibling through the parent
+		child.Keys = append([]interface{}{node.Keys[index-1]}, child.Keys...)
+		child.Children = append([]*Node{left.Children[len(left.Children)-1]}, child.Children...)
+		node.Keys[index-1] = left.Keys[len(left.Keys)-1]
+		left.Keys = left.Keys[:len(left.Keys)-1]
+		left.Children = left.Children[:len(left.Children)-1]
+	case right != nil && len(right.Children) > tree.minChildren():
+		// rotate the first child of the right sibling through the parent
+		child.Keys = append(child.Keys, node.Keys[index])
+		child.Children = append(child.Children, right.Children[0])
+		node.Keys[index] = right.Keys[0]
+		right.Keys = append(right.Keys[:0], right.Keys[1:]...)
+		right.Children = append(right.Children[:0], right.Children[1:]...)
+	case left != nil:
+		tree.mergeInternals(node, index-1)
+	default:
+		tree.mergeInternals(node, index)
+	}
+}
+
+// mergeLeaves moves the entries of the leaf right of the child at the index of the internal node into the child and
+// removes the emptied leaf.
+func (tree *Tree) mergeLeaves(node *Node, index int) {
+	left, right := node.Children[index], node.Children[index+1]
+	left.Entries = append(left.Entries, right.Entries...)
+	left.Next = right.Next
+	if right.Next != nil {
+		right.Next.Prev = left
+	}
+	tree.deleteChild(node, index)
+}
+
+// mergeInternals moves the separating key and the children of the node right of the child at the index of the
+// internal node into the child and removes the emptied node.
+func (tree *Tree) mergeInternals(node *Node, index int) {
+	left, right := node.Children[index], node.Children[index+1]
+	left.Keys = append(append(left.Keys, node.Keys[index]), right.Keys...)
+	left.Children = append(left.Children, right.Children...)
+	tree.deleteChild(node, index)
+}
+
+// deleteChild removes the child right of the key at the index of the internal node together with the key.
+func (tree *Tree) deleteChild(node *Node, index int) {
+	node.Keys = append(node.Keys[:index], node.Keys[index+1:]...)
+	node.Children = append(node.Children[:index+1], node.Children[index+2:]...)
+}
@@ -0,0 +1,869 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bplustree
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/uncle-gua/gods/containers"
+	"math/rand"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestBPlusTreePut(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	assertBPlusTree(t, tree)
+	tree.Put(5, "e")
+	tree.Put(6, "f")
+	tree.Put(7, "g")
+	tree.Put(3, "c")
+	tree.Put(4, "d")
+	tree.Put(1, "x")
+	tree.Put(2, "b")
+	tree.Put(1, "a") //overwrite
+	assertBPlusTree(t, tree)
+
+	if actualValue := tree.Size(); actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%d%d%d%d%d%d%d", tree.Keys()...), "1234567"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%s%s%s%s%s%s%s", tree.Values()...), "abcdefg"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tests := [][]interface{}{
+		{1, "a", true},
+		{2, "b", true},
+		{3, "c", true},
+		{4, "d", true},
+		{5, "e", true},
+		{6, "f", true},
+		{7, "g", true},
+		{8, nil, false},
+		{0, nil, false},
+	}
+	for _, test := range tests {
+		actualValue, actualFound := tree.Get(test[0])
+		if actualValue != test[1] || actualFound != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+		if actualValue, expectedValue := tree.GetNode(test[0]) != nil, test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestBPlusTreeRemove(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	tree.Remove(1)
+	for i := 1; i <= 7; i++ {
+		tree.Put(i, fmt.Sprintf("%d", i))
+	}
+
+	tree.Remove(5)
+	tree.Remove(6)
+	tree.Remove(7)
+	tree.Remove(8)
+	tree.Remove(5)
+	assertBPlusTree(t, tree)
+
+	if actualValue, expectedValue := fmt.Sprintf("%d%d%d%d", tree.Keys()...), "1234"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := tree.Size(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+
+	tree.Remove(1)
+	tree.Remove(4)
+	tree.Remove(2)
+	tree.Remove(3)
+	tree.Remove(2)
+	tree.Remove(2)
+	assertBPlusTree(t, tree)
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), "[]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if empty, size := tree.Empty(), tree.Size(); empty != true || size != 0 {
+		t.Errorf("Got %v expected %v", empty, true)
+	}
+	if tree.Root != nil {
+		t.Errorf("Got %v expected %v", tree.Root, nil)
+	}
+}
+
+func TestBPlusTreeRandom(t *testing.T) {
+	random := rand.New(rand.NewSource(3))
+	for order := 3; order <= 7; order++ {
+		tree, expected := randomBPlusTree(t, random, order)
+		keys := make([]int, 0, len(expected))
+		for key := range expected {
+			keys = append(keys, key)
+		}
+		sort.Ints(keys)
+		if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), fmt.Sprintf("%v", keys); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		for key, value := range expected {
+			if actualValue, found := tree.Get(key); actualValue != value || !found {
+				t.Errorf("Got %v expected %v", actualValue, value)
+			}
+		}
+		assertBPlusTreeSeek(t, tree, keys)
+
+		for _, key := range keys {
+			tree.Remove(key)
+		}
+		assertBPlusTree(t, tree)
+		if actualValue := tree.Size(); actualValue != 0 {
+			t.Errorf("Got %v expected %v", actualValue, 0)
+		}
+	}
+}
+
+// randomBPlusTree returns a tree of the order built by random puts and removes along with the expected entries.
+func randomBPlusTree(t *testing.T, random *rand.Rand, order int) (*Tree, map[int]int) {
+	t.Helper()
+	tree := NewWithIntComparator(order)
+	expected := map[int]int{}
+	for i := 0; i < 2000; i++ {
+		key := random.Intn(300)
+		if random.Intn(3) == 0 {
+			tree.Remove(key)
+			delete(expected, key)
+		} else {
+			tree.Put(key, i)
+			expected[key] = i
+		}
+		if i%50 == 0 {
+			assertBPlusTree(t, tree)
+		}
+	}
+	assertBPlusTree(t, tree)
+	return tree, expected
+}
+
+// assertBPlusTreeSeek seeks every key around the sorted keys of the tree in both directions.
+func assertBPlusTreeSeek(t *testing.T, tree *Tree, keys []int) {
+	t.Helper()
+	it := tree.Iterator()
+	for key := -1; key <= 300; key++ {
+		index := sort.SearchInts(keys, key)
+		if found := it.SeekGE(key); found != (index < len(keys)) || found && it.Key() != keys[index] {
+			t.Errorf("Got %v expected %v", found, index < len(keys))
+		}
+		if index < len(keys) && keys[index] == key {
+			index++
+		}
+		if found := it.SeekLE(key); found != (index > 0) || found && it.Key() != keys[index-1] {
+			t.Errorf("Got %v expected %v", found, index > 0)
+		}
+	}
+}
+
+// assertBPlusTree checks that all leaves are in the same level, that the nodes are neither overfull nor underfull,
+// that the keys of internal nodes separate their children and that the leaves are linked in order.
+// bplusTreeChecker walks a tree to check its invariants, collecting its leaves to check their links.
+type bplusTreeChecker struct {
+	t         *testing.T
+	tree      *Tree
+	leafDepth int
+	leaves    []*Node
+}
+
+func assertBPlusTree(t *testing.T, tree *Tree) {
+	t.Helper()
+	checker := &bplusTreeChecker{t: t, tree: tree, leafDepth: -1}
+	size := 0
+	if tree.Root != nil {
+		size = checker.check(tree.Root, true, 0, nil, nil)
+	}
+	if size != tree.Size() {
+		t.Errorf("Got %v expected %v", size, tree.Size())
+	}
+	checker.checkLinks()
+}
+
+// within returns true if the key is within [lower, upper), nil bounds being unbounded.
+func (checker *bplusTreeChecker) within(key, lower, upper interface{}) bool {
+	comparator := checker.tree.Comparator
+	return (lower == nil || comparator(key, lower) >= 0) && (upper == nil || comparator(key, upper) < 0)
+}
+
+// check returns the number of entries in the subtree, whose keys must be within [lower, upper).
+func (checker *bplusTreeChecker) check(node *Node, root bool, depth int, lower, upper interface{}) int {
+	checker.t.Helper()
+	if checker.tree.isLeaf(node) {
+		checker.checkLeaf(node, root, depth, lower, upper)
+		return len(node.Entries)
+	}
+	checker.checkInternal(node, root, lower, upper)
+	size := 0
+	for i, child := range node.Children {
+		childLower, childUpper := lower, upper
+		if i > 0 {
+			childLower = node.Keys[i-1]
+		}
+		if i < len(node.Keys) {
+			childUpper = node.Keys[i]
+		}
+		size += checker.check(child, false, depth+1, childLower, childUpper)
+	}
+	return size
+}
+
+func (checker *bplusTreeChecker) checkLeaf(node *Node, root bool, depth int, lower, upper interface{}) {
+	checker.t.Helper()
+	tree := checker.tree
+	if len(node.Entries) > tree.maxEntries() || !root && len(node.Entries) < tree.minEntries() || len(node.Entries) == 0 {
+		checker.t.Errorf("Got %v entries in leaf %v", len(node.Entries), node.Entries)
+	}
+	for i, entry := range node.Entries {
+		if !checker.within(entry.Key, lower, upper) || i > 0 && tree.Comparator(node.Entries[i-1].Key, entry.Key) >= 0 {
+			checker.t.Errorf("Got key %v out of order in leaf %v", entry.Key, node.Entries)
+		}
+	}
+	if checker.leafDepth == -1 {
+		checker.leafDepth = depth
+	}
+	if depth != checker.leafDepth {
+		checker.t.Errorf("Got leaf depth %v expected %v", depth, checker.leafDepth)
+	}
+	checker.leaves = append(checker.leaves, node)
+}
+
+func (checker *bplusTreeChecker) checkInternal(node *Node, root bool, lower, upper interface{}) {
+	checker.t.Helper()
+	tree := checker.tree
+	if len(node.Entries) != 0 || len(node.Keys) != len(node.Children)-1 {
+		checker.t.Errorf("Got %v keys and %v children in internal node", len(node.Keys), len(node.Children))
+	}
+	if len(node.Children) > tree.maxChildren() || !root && len(node.Children) < tree.minChildren() || len(node.Children) < 2 {
+		checker.t.Errorf("Got %v children in internal node %v", len(node.Children), node.Keys)
+	}
+	for i, key := range node.Keys {
+		if !checker.within(key, lower, upper) || i > 0 && tree.Comparator(node.Keys[i-1], key) >= 0 {
+			checker.t.Errorf("Got separator %v out of order in internal node %v", key, node.Keys)
+		}
+	}
+}
+
+// checkLinks checks that the leaves are linked in order.
+func (checker *bplusTreeChecker) checkLinks() {
+	checker.t.Helper()
+	leaves := checker.leaves
+	for i, leaf := range leaves {
+		var prev, next *Node
+		if i > 0 {
+			prev = leaves[i-1]
+		}
+		if i < len(leaves)-1 {
+			next = leaves[i+1]
+		}
+		if leaf.Prev != prev || leaf.Next != next {
+			checker.t.Errorf("Got wrong links of leaf %v", leaf.Entries)
+		}
+	}
+}
+
+func TestBPlusTreeHeight(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	if actualValue, expectedValue := tree.Height(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tree.Put(1, 0)
+	if actualValue, expectedValue := tree.Height(), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tree.Put(2, 1)
+	if actualValue, expectedValue := tree.Height(), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tree.Put(3, 2)
+	if actualValue, expectedValue := tree.Height(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	for i := 4; i <= 10; i++ {
+		tree.Put(i, i)
+	}
+	assertBPlusTree(t, tree)
+	if actualValue, expectedValue := tree.Height(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	for i := 1; i <= 10; i++ {
+		tree.Remove(i)
+	}
+	if actualValue, expectedValue := tree.Height(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBPlusTreeLeftAndRight(t *testing.T) {
+	tree := NewWithIntComparator(3)
+
+	if actualValue := tree.Left(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := tree.Right(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	tree.Put(1, "a")
+	tree.Put(5, "e")
+	tree.Put(6, "f")
+	tree.Put(7, "g")
+	tree.Put(3, "c")
+	tree.Put(4, "d")
+	tree.Put(1, "x") // overwrite
+	tree.Put(2, "b")
+
+	if actualValue, expectedValue := tree.LeftKey(), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := tree.LeftValue(), "x"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue, expectedValue := tree.RightKey(), 7; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := tree.RightValue(), "g"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBPlusTreeIteratorNextOnEmpty(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	it := tree.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty tree")
+	}
+}
+
+func TestBPlusTreeIteratorPrevOnEmpty(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	it := tree.Iterator()
+	for it.Prev() {
+		t.Errorf("Shouldn't iterate on empty tree")
+	}
+}
+
+func TestBPlusTreeIteratorNext(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	for i := 20; i > 0; i-- {
+		tree.Put(i, i*10)
+	}
+	it := tree.Iterator()
+	count := 0
+	for it.Next() {
+		count++
+		key := it.Key()
+		value := it.Value()
+		if actualValue, expectedValue := key, count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := value, count*10; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if _, found := tree.search(it.Node(), key); !found {
+			t.Errorf("Got leaf %v without key %v", it.Node().Entries, key)
+		}
+	}
+	if actualValue, expectedValue := count, tree.Size(); actualValue != expectedValue {
+		t.Errorf("Size different. Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBPlusTreeIteratorPrev(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	for i := 1; i <= 20; i++ {
+		tree.Put(i, i*10)
+	}
+	it := tree.Iterator()
+	for it.Next() {
+	}
+	countDown := tree.Size()
+	for it.Prev() {
+		key := it.Key()
+		value := it.Value()
+		if actualValue, expectedValue := key, countDown; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := value, countDown*10; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		countDown--
+	}
+	if actualValue, expectedValue := countDown, 0; actualValue != expectedValue {
+		t.Errorf("Size different. Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBPlusTreeIteratorBeginEndFirstLast(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	tree.Put(3, "c")
+	tree.Put(1, "a")
+	tree.Put(2, "b")
+	it := tree.Iterator()
+
+	it.End()
+	it.Begin()
+	if actualValue, expectedValue := it.Next(), true; actualValue != expectedValue || it.Key() != 1 {
+		t.Errorf("Got %v expected %v", it.Key(), 1)
+	}
+	it.Begin()
+	if it.Prev() {
+		t.Errorf("Shouldn't iterate before the first element")
+	}
+	it.End()
+	if it.Next() {
+		t.Errorf("Shouldn't iterate past the last element")
+	}
+	if actualValue, expectedValue := it.Prev(), true; actualValue != expectedValue || it.Key() != 3 {
+		t.Errorf("Got %v expected %v", it.Key(), 3)
+	}
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue || it.Key() != 1 || it.Value() != "a" {
+		t.Errorf("Got %v expected %v", it.Key(), 1)
+	}
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue || it.Key() != 3 || it.Value() != "c" {
+		t.Errorf("Got %v expected %v", it.Key(), 3)
+	}
+}
+
+func TestBPlusTreeIteratorNextToAndPrevTo(t *testing.T) {
+	// Sample seek function, i.e. string starting with "b"
+	seek := func(index interface{}, value interface{}) bool {
+		return strings.HasSuffix(value.(string), "b")
+	}
+
+	tree := NewWithIntComparator(3)
+	tree.Put(2, "cc")
+	tree.Put(0, "aa")
+	tree.Put(1, "bb")
+	tree.Put(3, "dd")
+
+	it := tree.Iterator()
+	if !it.NextTo(seek) || it.Key() != 1 || it.Value() != "bb" {
+		t.Errorf("Got %v expected %v", it.Key(), 1)
+	}
+	if it.NextTo(seek) {
+		t.Errorf("Shouldn't iterate past the only matching element")
+	}
+
+	it.End()
+	if !it.PrevTo(seek) || it.Key() != 1 || it.Value() != "bb" {
+		t.Errorf("Got %v expected %v", it.Key(), 1)
+	}
+	if it.PrevTo(seek) {
+		t.Errorf("Shouldn't iterate before the only matching element")
+	}
+}
+
+func TestBPlusTreeIteratorSeek(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	for i := 2; i <= 20; i += 2 {
+		tree.Put(i, i)
+	}
+	it := tree.Iterator()
+	tests := [][]interface{}{
+		{it.SeekGE, 4, true, 4},
+		{it.SeekGE, 5, true, 6},
+		{it.SeekGE, 0, true, 2},
+		{it.SeekGE, 21, false, nil},
+		{it.SeekGT, 4, true, 6},
+		{it.SeekGT, 20, false, nil},
+		{it.SeekLE, 4, true, 4},
+		{it.SeekLE, 5, true, 4},
+		{it.SeekLE, 1, false, nil},
+		{it.SeekLT, 4, true, 2},
+		{it.SeekLT, 100, true, 20},
+		{it.SeekLT, 2, false, nil},
+	}
+	for _, test := range tests {
+		found := test[0].(func(interface{}) bool)(test[1])
+		if actualValue, expectedValue := found, test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if found && it.Key() != test[3] {
+			t.Errorf("Got %v expected %v", it.Key(), test[3])
+		}
+	}
+
+	// iteration continues from the sought element in both directions
+	it.SeekGE(5)
+	if !it.Next() || it.Key() != 8 {
+		t.Errorf("Got %v expected %v", it.Key(), 8)
+	}
+	it.SeekGE(5)
+	if !it.Prev() || it.Key() != 4 {
+		t.Errorf("Got %v expected %v", it.Key(), 4)
+	}
+	it.SeekGT(20)
+	if !it.Prev() || it.Key() != 20 {
+		t.Errorf("Got %v expected %v", it.Key(), 20)
+	}
+	it.SeekLT(2)
+	if !it.Next() || it.Key() != 2 {
+		t.Errorf("Got %v expected %v", it.Key(), 2)
+	}
+}
+
+func TestBPlusTreeRangeIterator(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	for i := 2; i <= 20; i += 2 {
+		tree.Put(i, i)
+	}
+	it := tree.RangeIterator(5, 15)
+	keys := []interface{}{}
+	for it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[6 8 10 12 14]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	keys = []interface{}{}
+	for it.Prev() {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[14 12 10 8 6]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tests := [][]interface{}{
+		{it.First, 6},
+		{it.Last, 14},
+		{func() bool { return it.SeekGE(1) }, 6},
+		{func() bool { return it.SeekGT(10) }, 12},
+		{func() bool { return it.SeekLE(100) }, 14},
+		{func() bool { return it.SeekLT(6) }, nil},
+		{func() bool { return it.SeekGE(15) }, nil},
+	}
+	for _, test := range tests {
+		found := test[0].(func() bool)()
+		if actualValue, expectedValue := found, test[1] != nil; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if found && it.Key() != test[1] {
+			t.Errorf("Got %v expected %v", it.Key(), test[1])
+		}
+	}
+
+	// empty ranges
+	for _, it := range []Iterator{tree.RangeIterator(7, 8), tree.RangeIterator(30, 40), tree.RangeIterator(10, 5)} {
+		if it.Next() || it.Last() {
+			t.Errorf("Shouldn't iterate over empty range")
+		}
+	}
+}
+
+func TestBPlusTreeSeq(t *testing.T) {
+	tree := NewWithStringComparator(3)
+	tree.Put("c", 3)
+	tree.Put("a", 1)
+	tree.Put("b", 2)
+	tree.Put("d", 4)
+
+	pairs := []string{}
+	for key, value := range tree.AllSeq() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "a:1 b:2 c:3 d:4"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(tree.KeysSeq())), "[a b c d]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(tree.ValuesSeq())), "[1 2 3 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	pairs = []string{}
+	for key, value := range tree.Backward() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "d:4 c:3 b:2 a:1"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	pairs = []string{}
+	for key, value := range tree.RangeSeq("b", "d") {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "b:2 c:3"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	count := 0
+	for key := range tree.RangeSeq("a", "z") {
+		if key == "b" {
+			break
+		}
+		count++
+	}
+	if actualValue, expectedValue := count, 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBPlusTreeSerialization(t *testing.T) {
+	tree := NewWithStringComparator(3)
+	tree.Put("c", "3")
+	tree.Put("b", "2")
+	tree.Put("a", "1")
+
+	var err error
+	assert := func() {
+		if actualValue, expectedValue := tree.Size(), 3; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue := tree.Keys(); actualValue[0].(string) != "a" || actualValue[1].(string) != "b" || actualValue[2].(string) != "c" {
+			t.Errorf("Got %v expected %v", actualValue, "[a,b,c]")
+		}
+		if actualValue := tree.Values(); actualValue[0].(string) != "1" || actualValue[1].(string) != "2" || actualValue[2].(string) != "3" {
+			t.Errorf("Got %v expected %v", actualValue, "[1,2,3]")
+		}
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	assert()
+
+	bytes, err := tree.ToJSON()
+	assert()
+
+	err = tree.FromJSON(bytes)
+	assert()
+
+	bytes, err = json.Marshal([]interface{}{"a", "b", "c", tree})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"a":1,"b":2}`), &tree)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = tree.FromJSON([]byte(`{"c":3,"a":1,"e":5,"b":2,"d":4}`))
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	assertBPlusTree(t, tree)
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), "[a b c d e]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBPlusTreeString(t *testing.T) {
+	c := NewWithStringComparator(3)
+	c.Put("a", 1)
+	if !strings.HasPrefix(c.String(), "BPlusTree") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func TestBPlusTreeIteratorConcurrentModification(t *testing.T) {
+	tree := NewWithStringComparator(3)
+	tree.Put("a", 1)
+	tree.Put("b", 2)
+	tree.Put("c", 3)
+
+	// modifications before the first element are allowed
+	it := tree.Iterator()
+	tree.Put("d", 4)
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, tree.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// modifications after Begin() or End() are allowed, moving in either direction accepts them
+	it.End()
+	tree.Put("f", 6)
+	if it.Next() {
+		t.Errorf("Should not iterate past the last element")
+	}
+	if !it.Prev() || it.Key() != "f" {
+		t.Errorf("Got %v expected %v", it.Key(), "f")
+	}
+	it.Begin()
+	tree.Remove("f")
+	if it.Prev() {
+		t.Errorf("Should not iterate before the first element")
+	}
+	if !it.Next() || it.Key() != "a" {
+		t.Errorf("Got %v expected %v", it.Key(), "a")
+	}
+
+	// modifications during iteration panic
+	func() {
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		it := tree.Iterator()
+		it.Next()
+		tree.Remove("a")
+		it.Next()
+	}()
+
+	// checked iterator stops and reports the error
+	checked := tree.CheckedIterator()
+	checked.Next()
+	tree.Put("e", 5)
+	if checked.Next() {
+		t.Errorf("Should not iterate after modification")
+	}
+	if actualValue, expectedValue := checked.Err(), containers.ErrConcurrentModification; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checked.Begin()
+	if actualValue := checked.Err(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	count = 0
+	for checked.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, tree.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBPlusTreeIteratorModifiedAtBoundaries(t *testing.T) {
+	tree := NewWithStringComparator(3)
+	tree.Put("b", 0)
+	tree.Put("c", 0)
+	it := tree.Iterator()
+	it.Next()
+
+	// moving from Begin() or End() after a modification starts over on the modified container
+	tests := [][]interface{}{
+		{true, true, "a", "a"},
+		{false, true, "d", "d"},
+		{true, false, "a", "b"},
+		{false, false, "d", "c"},
+	}
+	for _, test := range tests {
+		forward, put, key, expectedValue := test[0].(bool), test[1].(bool), test[2], test[3]
+		if forward {
+			it.Begin()
+		} else {
+			it.End()
+		}
+		if put {
+			tree.Put(key, 0)
+		} else {
+			tree.Remove(key)
+		}
+		moved := false
+		if forward {
+			moved = it.Next()
+		} else {
+			moved = it.Prev()
+		}
+		if !moved {
+			t.Errorf("Got %v expected %v", moved, true)
+			continue
+		}
+		if actualValue := it.Key(); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestBPlusTreeIteratorClone(t *testing.T) {
+	tree := NewWithStringComparator(3)
+	tree.Put("a", 1)
+	tree.Put("b", 2)
+	tree.Put("c", 3)
+
+	it := tree.Iterator()
+	it.Next()
+	clone := it.Clone()
+	first := it.Key()
+	it.Next()
+	if actualValue, expectedValue := clone.Key(), first; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !clone.Next() || clone.Key() != it.Key() {
+		t.Errorf("Got %v expected %v", clone.Key(), it.Key())
+	}
+	clone.Next()
+	if clone.Next() {
+		t.Errorf("Should not go past the last element")
+	}
+	if !it.Next() {
+		t.Errorf("Should move independently of the clone")
+	}
+}
+
+func benchmarkGet(b *testing.B, tree *Tree, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			tree.Get(n)
+		}
+	}
+}
+
+func benchmarkPut(b *testing.B, tree *Tree, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			tree.Put(n, struct{}{})
+		}
+	}
+}
+
+func benchmarkRangeScan(b *testing.B, tree *Tree, size int) {
+	for i := 0; i < b.N; i++ {
+		for range tree.RangeSeq(size/4, size/4*3) {
+		}
+	}
+}
+
+func BenchmarkBPlusTreeGet10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	tree := NewWithIntComparator(128)
+	for n := 0; n < size; n++ {
+		tree.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, tree, size)
+}
+
+func BenchmarkBPlusTreePut10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	tree := NewWithIntComparator(128)
+	b.StartTimer()
+	benchmarkPut(b, tree, size)
+}
+
+func BenchmarkBPlusTreeRangeScan10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	tree := NewWithIntComparator(128)
+	for n := 0; n < size; n++ {
+		tree.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkRangeScan(b, tree, size)
+}
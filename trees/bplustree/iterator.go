@@ -0,0 +1,359 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bplustree
+
+import (
+	"iter"
+
+	"github.com/uncle-gua/gods/containers"
+)
+
+// Assert Iterator implementation
+var _ containers.ReverseIteratorWithKey = (*Iterator)(nil)
+
+// Iterator holding the iterator's state
+type Iterator struct {
+	tree     *Tree
+	node     *Node // current leaf
+	index    int   // index of the current entry in the leaf
+	position position
+	bounded  bool
+	from, to interface{}
+	failFast containers.FailFast
+}
+
+type position byte
+
+const (
+	begin, between, end position = 0, 1, 2
+)
+
+// Iterator returns a stateful iterator whose elements are key/value pairs.
+// Moving the iterator follows the links between the leaves, i.e. it takes constant time without climbing the tree.
+// The iterator fails fast: moving it after the tree was structurally modified other than through the iterator
+// panics with containers.ErrConcurrentModification. Modifications made while the iterator is before the first
+// or past the last element, e.g. after Begin() or End(), are allowed.
+func (tree *Tree) Iterator() Iterator {
+	return Iterator{tree: tree, node: nil, position: begin, failFast: containers.NewFailFast(tree.modCount)}
+}
+
+// CheckedIterator returns a stateful iterator like Iterator(), except that instead of panicking when the tree was
+// modified during iteration, it stops and reports containers.ErrConcurrentModification by Err().
+func (tree *Tree) CheckedIterator() Iterator {
+	iterator := tree.Iterator()
+	iterator.failFast.Check()
+	return iterator
+}
+
+// RangeIterator returns a stateful iterator like Iterator(), restricted to the elements whose keys are in the
+// half-open range [from, to), i.e. it moves from its initial state to the first element not smaller than from,
+// and past its last element when the next element would not be smaller than to (and vice versa in reverse).
+// Finding the first element takes O(log n) time, moving to each next element constant time.
+// Keys should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) RangeIterator(from, to interface{}) Iterator {
+	iterator := tree.Iterator()
+	iterator.bounded, iterator.from, iterator.to = true, from, to
+	return iterator
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator) Next() bool {
+	if !iterator.failFast.Move(iterator.tree.modCount, iterator.position != between) {
+		return false
+	}
+	switch iterator.position {
+	case end:
+		return false
+	case begin:
+		iterator.node, iterator.index = iterator.first()
+	default:
+		iterator.index++
+		if iterator.index == len(iterator.node.Entries) {
+			iterator.node, iterator.index = iterator.node.Next, 0
+		}
+	}
+	if iterator.node == nil || !iterator.withinBounds(iterator.Key()) {
+		iterator.End()
+		return false
+	}
+	iterator.position = between
+	return true
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) Prev() bool {
+	if !iterator.failFast.Move(iterator.tree.modCount, iterator.position != between) {
+		return false
+	}
+	switch iterator.position {
+	case begin:
+		return false
+	case end:
+		iterator.node, iterator.index = iterator.last()
+	default:
+		iterator.index--
+		if iterator.index < 0 {
+			if iterator.node = iterator.node.Prev; iterator.node != nil {
+				iterator.index = len(iterator.node.Entries) - 1
+			}
+		}
+	}
+	if iterator.node == nil || !iterator.withinBounds(iterator.Key()) {
+		iterator.Begin()
+		return false
+	}
+	iterator.position = between
+	return true
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Value() interface{} {
+	return iterator.node.Entries[iterator.index].Value
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Key() interface{} {
+	return iterator.node.Entries[iterator.index].Key
+}
+
+// Node returns the current element's leaf.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Node() *Node {
+	return iterator.node
+}
+
+// Clone returns an independent copy of the iterator at the same position, e.g. for lookahead or backtracking.
+// Moving either of the iterators does not move the other one.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Clone() Iterator {
+	return *iterator
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator) Begin() {
+	iterator.node = nil
+	iterator.index = 0
+	iterator.position = begin
+	iterator.sync()
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *Iterator) End() {
+	iterator.node = nil
+	iterator.index = 0
+	iterator.position = end
+	iterator.sync()
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator
+func (iterator *Iterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the container.
+// If Last() returns true, then last element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If NextTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) NextTo(f func(key interface{}, value interface{}) bool) bool {
+	for iterator.Next() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// PrevTo moves the iterator to the previous element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If PrevTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) PrevTo(f func(key interface{}, value interface{}) bool) bool {
+	for iterator.Prev() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// SeekGE moves the iterator to the first element whose key is greater than or equal to the given key and returns
+// true if there was such an element, otherwise moves the iterator past the last element.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekGE(key interface{}) bool {
+	return iterator.seekForward(iterator.tree.ceilingEntry(key, true))
+}
+
+// SeekGT moves the iterator to the first element whose key is greater than the given key and returns
+// true if there was such an element, otherwise moves the iterator past the last element.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekGT(key interface{}) bool {
+	return iterator.seekForward(iterator.tree.ceilingEntry(key, false))
+}
+
+// SeekLE moves the iterator to the last element whose key is smaller than or equal to the given key and returns
+// true if there was such an element, otherwise moves the iterator before the first element.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekLE(key interface{}) bool {
+	return iterator.seekBackward(iterator.tree.floorEntry(key, true))
+}
+
+// SeekLT moves the iterator to the last element whose key is smaller than the given key and returns
+// true if there was such an element, otherwise moves the iterator before the first element.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekLT(key interface{}) bool {
+	return iterator.seekBackward(iterator.tree.floorEntry(key, false))
+}
+
+// seekForward moves the iterator to the entry, or to the first entry if the entry is below the range of the iterator.
+func (iterator *Iterator) seekForward(leaf *Node, index int) bool {
+	if leaf != nil && iterator.bounded && iterator.tree.Comparator(leaf.Entries[index].Key, iterator.from) < 0 {
+		leaf, index = iterator.first()
+	}
+	if leaf == nil || !iterator.withinBounds(leaf.Entries[index].Key) {
+		iterator.End()
+		return false
+	}
+	iterator.sync()
+	iterator.node, iterator.index, iterator.position = leaf, index, between
+	return true
+}
+
+// seekBackward moves the iterator to the entry, or to the last entry if the entry is above the range of the iterator.
+func (iterator *Iterator) seekBackward(leaf *Node, index int) bool {
+	if leaf != nil && iterator.bounded && iterator.tree.Comparator(leaf.Entries[index].Key, iterator.to) >= 0 {
+		leaf, index = iterator.last()
+	}
+	if leaf == nil || !iterator.withinBounds(leaf.Entries[index].Key) {
+		iterator.Begin()
+		return false
+	}
+	iterator.sync()
+	iterator.node, iterator.index, iterator.position = leaf, index, between
+	return true
+}
+
+// first returns the leaf and the index of the first entry within the range of the iterator.
+func (iterator *Iterator) first() (*Node, int) {
+	if iterator.bounded {
+		return iterator.tree.ceilingEntry(iterator.from, true)
+	}
+	return iterator.tree.Left(), 0
+}
+
+// last returns the leaf and the index of the last entry within the range of the iterator.
+func (iterator *Iterator) last() (*Node, int) {
+	if iterator.bounded {
+		return iterator.tree.floorEntry(iterator.to, false)
+	}
+	right := iterator.tree.Right()
+	if right == nil {
+		return nil, 0
+	}
+	return right, len(right.Entries) - 1
+}
+
+// withinBounds returns true if the key is within the range of the iterator.
+func (iterator *Iterator) withinBounds(key interface{}) bool {
+	return !iterator.bounded ||
+		iterator.tree.Comparator(key, iterator.from) >= 0 && iterator.tree.Comparator(key, iterator.to) < 0
+}
+
+// Err returns containers.ErrConcurrentModification if the checked iterator stopped because the tree was modified
+// during iteration, otherwise nil.
+func (iterator *Iterator) Err() error {
+	return iterator.failFast.Err()
+}
+
+// sync accepts the current state of the tree, i.e. after the iterator was reset.
+func (iterator *Iterator) sync() {
+	iterator.failFast.Sync(iterator.tree.modCount)
+}
+
+// AllSeq returns an iterator over key-value pairs of the tree in order, for use with range-over-func.
+func (tree *Tree) AllSeq() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		iterator := tree.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Key(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns an iterator over keys of the tree in order, for use with range-over-func.
+// It is not named Keys, which returns the keys as a slice.
+func (tree *Tree) KeysSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		iterator := tree.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Key()) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over values of the tree in order of their keys, for use with range-over-func.
+// It is not named Values, which returns the values as a slice.
+func (tree *Tree) ValuesSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		iterator := tree.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over key-value pairs of the tree in reverse order, for use with range-over-func.
+func (tree *Tree) Backward() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		iterator := tree.Iterator()
+		iterator.End()
+		for iterator.Prev() {
+			if !yield(iterator.Key(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// RangeSeq returns an iterator over key-value pairs of the tree whose keys are in the half-open range [from, to)
+// in order, for use with range-over-func.
+// Keys should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) RangeSeq(from, to interface{}) iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		iterator := tree.RangeIterator(from, to)
+		for iterator.Next() {
+			if !yield(iterator.Key(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
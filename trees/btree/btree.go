@@ -19,6 +19,7 @@ package btree
 import (
 	"bytes"
 	"fmt"
+	"github.com/uncle-gua/gods/containers"
 	"github.com/uncle-gua/gods/trees"
 	"github.com/uncle-gua/gods/utils"
 	"strings"
@@ -26,6 +27,7 @@ import (
 
 // Assert Tree implementation
 var _ trees.Tree = (*Tree)(nil)
+var _ containers.Validator = (*Tree)(nil)
 
 // Tree holds elements of the B-tree
 type Tree struct {
@@ -33,6 +35,7 @@ type Tree struct {
 	Comparator utils.Comparator // Key comparator
 	size       int              // Total number of keys in the tree
 	m          int              // order (maximum number of children)
+	modCount   int              // number of structural modifications, checked by iterators
 }
 
 // Node is a single element within the tree
@@ -40,6 +43,7 @@ type Node struct {
 	Parent   *Node
 	Entries  []*Entry // Contained keys in node
 	Children []*Node  // Children nodes
+	size     int      // Number of entries in the subtree rooted at this node
 }
 
 // Entry represents the key-value pair contained within nodes
@@ -66,6 +70,66 @@ func NewWithStringComparator(order int) *Tree {
 	return NewWith(order, utils.StringComparator)
 }
 
+// NewFromSorted instantiates a B-tree with the order (maximum number of children) and a custom key comparator from
+// keys in strictly increasing order and their values in O(n) time, i.e. without splitting nodes.
+// Returns an error wrapping trees.ErrUnsorted if the keys are not in strictly increasing order.
+func NewFromSorted(order int, comparator utils.Comparator, keys []interface{}, values []interface{}) (*Tree, error) {
+	tree := NewWith(order, comparator)
+	if err := tree.LoadSorted(keys, values); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+// NewFromIterator instantiates a B-tree with the order (maximum number of children) and a custom key comparator
+// from the remaining elements of an iterator whose keys are in strictly increasing order, e.g. one of an ordered map,
+// in O(n) time.
+// Returns an error wrapping trees.ErrUnsorted if the keys are not in strictly increasing order.
+func NewFromIterator(order int, comparator utils.Comparator, iterator containers.IteratorWithKey) (*Tree, error) {
+	keys, values := []interface{}{}, []interface{}{}
+	for iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	return NewFromSorted(order, comparator, keys, values)
+}
+
+// LoadSorted replaces all entries of the tree by keys in strictly increasing order and their values in O(n) time,
+// i.e. without splitting nodes.
+// Returns an error wrapping trees.ErrUnsorted if the keys are not in strictly increasing order,
+// in which case the tree is not modified.
+// Keys should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) LoadSorted(keys []interface{}, values []interface{}) error {
+	if len(keys) != len(values) {
+		return fmt.Errorf("btree: got %d keys and %d values", len(keys), len(values))
+	}
+	for i := 1; i < len(keys); i++ {
+		if tree.Comparator(keys[i-1], keys[i]) >= 0 {
+			return fmt.Errorf("%w: key %v at index %d is not greater than key %v", trees.ErrUnsorted, keys[i], i, keys[i-1])
+		}
+	}
+	if len(keys) == 1 {
+		// Assert key is of comparator's type
+		tree.Comparator(keys[0], keys[0])
+	}
+	entries := make([]*Entry, len(keys))
+	for i := range keys {
+		entries[i] = &Entry{Key: keys[i], Value: values[i]}
+	}
+	// capacities[h] is the maximum number of entries in a subtree of height h+1, plus one
+	capacities := []int{tree.maxChildren()}
+	for capacities[len(capacities)-1] <= len(entries) {
+		capacities = append(capacities, capacities[len(capacities)-1]*tree.maxChildren())
+	}
+	tree.Root = nil
+	if len(entries) > 0 {
+		tree.Root = tree.build(entries, capacities[:len(capacities)-1], nil)
+	}
+	tree.size = len(entries)
+	tree.modCount++
+	return nil
+}
+
 // Put inserts key-value pair node into the tree.
 // If key already exists, then its value is updated with the new value.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
@@ -73,13 +137,15 @@ func (tree *Tree) Put(key interface{}, value interface{}) {
 	entry := &Entry{Key: key, Value: value}
 
 	if tree.Root == nil {
-		tree.Root = &Node{Entries: []*Entry{entry}, Children: []*Node{}}
+		tree.Root = &Node{Entries: []*Entry{entry}, Children: []*Node{}, size: 1}
 		tree.size++
+		tree.modCount++
 		return
 	}
 
 	if tree.insert(tree.Root, entry) {
 		tree.size++
+		tree.modCount++
 	}
 }
 
@@ -108,6 +174,7 @@ func (tree *Tree) Remove(key interface{}) {
 	if found {
 		tree.delete(node, index)
 		tree.size--
+		tree.modCount++
 	}
 }
 
@@ -134,6 +201,55 @@ func (node *Node) Size() int {
 	return size
 }
 
+// Rank returns the number of keys in the tree that are smaller than the given key in O(log n) time,
+// i.e. the index the key has or would have if it were put into the tree.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Rank(key interface{}) int {
+	rank, _ := tree.rank(key)
+	return rank
+}
+
+// IndexOf returns the index of the key in the in-order sequence of keys in O(log n) time,
+// or -1 if key is not found in tree.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) IndexOf(key interface{}) int {
+	if rank, found := tree.rank(key); found {
+		return rank
+	}
+	return -1
+}
+
+// GetAt returns the entry at the given index in the in-order sequence of entries in O(log n) time.
+// Second return parameter is true if index is within bounds, otherwise false.
+func (tree *Tree) GetAt(index int) (entry *Entry, found bool) {
+	if index < 0 || index >= tree.size {
+		return nil, false
+	}
+	node := tree.Root
+	for !tree.isLeaf(node) {
+		i := 0
+		for index >= node.Children[i].size {
+			index -= node.Children[i].size
+			if index == 0 {
+				return node.Entries[i], true
+			}
+			index--
+			i++
+		}
+		node = node.Children[i]
+	}
+	return node.Entries[index], true
+}
+
+// CountRange returns the number of keys in the half-open range [from, to) in O(log n) time.
+// Keys should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) CountRange(from, to interface{}) int {
+	if tree.Comparator(from, to) >= 0 {
+		return 0
+	}
+	return tree.Rank(to) - tree.Rank(from)
+}
+
 // Keys returns all keys in-order
 func (tree *Tree) Keys() []interface{} {
 	keys := make([]interface{}, tree.size)
@@ -158,6 +274,7 @@ func (tree *Tree) Values() []interface{} {
 func (tree *Tree) Clear() {
 	tree.Root = nil
 	tree.size = 0
+	tree.modCount++
 }
 
 // Height returns the height of the tree.
@@ -217,6 +334,115 @@ func (tree *Tree) String() string {
 	return buffer.String()
 }
 
+// Validate checks that every node has at most the maximum and, except the root, at least the minimum number of
+// entries, that internal nodes have one child more than entries, that the keys are in strictly increasing order
+// within and across nodes, that all leaves are at the same depth, and that the parent links and subtree sizes are
+// consistent. Returns an error wrapping containers.ErrInvalid, which describes the first violated rule and the node
+// where it was violated, or nil if the tree is valid.
+func (tree *Tree) Validate() error {
+	if tree.Root == nil {
+		if tree.size != 0 {
+			return fmt.Errorf("%w: empty tree has size %d", containers.ErrInvalid, tree.size)
+		}
+		return nil
+	}
+	if tree.Root.Parent != nil {
+		return fmt.Errorf("%w: root %v has parent %v", containers.ErrInvalid, tree.Root.Entries, tree.Root.Parent.Entries)
+	}
+	if err := tree.validate(tree.Root, 0, &validation{leafDepth: -1}); err != nil {
+		return err
+	}
+	if tree.size != tree.Root.size {
+		return fmt.Errorf("%w: tree has size %d instead of %d", containers.ErrInvalid, tree.size, tree.Root.size)
+	}
+	return nil
+}
+
+// validation holds the state of Validate while it visits the nodes in order.
+type validation struct {
+	leafDepth int    // depth of the first visited leaf, -1 before
+	previous  *Entry // last visited entry
+}
+
+// validate checks the subtree of the node at the given depth, see Validate.
+func (tree *Tree) validate(node *Node, depth int, state *validation) error {
+	if err := tree.validateNode(node, depth, state); err != nil {
+		return err
+	}
+	size := len(node.Entries)
+	for e := 0; e < len(node.Entries)+1; e++ {
+		if e < len(node.Children) {
+			child := node.Children[e]
+			if child.Parent != node {
+				return fmt.Errorf("%w: node %v does not link to its parent %v", containers.ErrInvalid, child.Entries, node.Entries)
+			}
+			if err := tree.validate(child, depth+1, state); err != nil {
+				return err
+			}
+			size += child.size
+		}
+		if e < len(node.Entries) {
+			entry := node.Entries[e]
+			if state.previous != nil && tree.Comparator(state.previous.Key, entry.Key) >= 0 {
+				return fmt.Errorf("%w: key %v of node %v is not greater than its predecessor %v", containers.ErrInvalid, entry, node.Entries, state.previous)
+			}
+			state.previous = entry
+		}
+	}
+	if node.size != size {
+		return fmt.Errorf("%w: node %v has size %d instead of %d", containers.ErrInvalid, node.Entries, node.size, size)
+	}
+	return nil
+}
+
+// validateNode checks the number of entries and children of the node and the depth of a leaf, see Validate.
+func (tree *Tree) validateNode(node *Node, depth int, state *validation) error {
+	if len(node.Entries) > tree.maxEntries() {
+		return fmt.Errorf("%w: node %v has %d entries, more than the maximum %d", containers.ErrInvalid, node.Entries, len(node.Entries), tree.maxEntries())
+	}
+	if node != tree.Root && len(node.Entries) < tree.minEntries() || len(node.Entries) == 0 {
+		return fmt.Errorf("%w: node %v has %d entries, fewer than the minimum %d", containers.ErrInvalid, node.Entries, len(node.Entries), max(tree.minEntries(), 1))
+	}
+	if !tree.isLeaf(node) {
+		if len(node.Children) != len(node.Entries)+1 {
+			return fmt.Errorf("%w: node %v has %d children instead of %d", containers.ErrInvalid, node.Entries, len(node.Children), len(node.Entries)+1)
+		}
+		return nil
+	}
+	if state.leafDepth == -1 {
+		state.leafDepth = depth
+	}
+	if depth != state.leafDepth {
+		return fmt.Errorf("%w: leaf %v is at depth %d instead of %d", containers.ErrInvalid, node.Entries, depth, state.leafDepth)
+	}
+	return nil
+}
+
+// ToDOT returns the tree in the Graphviz DOT language, with each node drawn as a record of its keys
+// and the edge to each child leaving from between the keys that bound it.
+func (tree *Tree) ToDOT() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("digraph BTree {\n")
+	buffer.WriteString("\tnode [shape=record];\n")
+	if !tree.Empty() {
+		id := 0
+		tree.dot(&buffer, tree.Root, &id)
+	}
+	buffer.WriteString("}\n")
+	return buffer.String()
+}
+
+// ToMermaid returns the tree as a Mermaid flowchart, with the keys of each node separated by bars.
+func (tree *Tree) ToMermaid() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("graph TD\n")
+	if !tree.Empty() {
+		id := 0
+		tree.mermaid(&buffer, tree.Root, &id)
+	}
+	return buffer.String()
+}
+
 func (entry *Entry) String() string {
 	return fmt.Sprintf("%v", entry.Key)
 }
@@ -233,6 +459,39 @@ func (tree *Tree) output(buffer *bytes.Buffer, node *Node, level int, isTail boo
 	}
 }
 
+func (tree *Tree) dot(buffer *bytes.Buffer, node *Node, id *int) {
+	name := fmt.Sprintf("n%d", *id)
+	*id++
+	fields := []string{}
+	for e := 0; e < len(node.Entries)+1; e++ {
+		if !tree.isLeaf(node) {
+			fields = append(fields, fmt.Sprintf("<c%d>", e))
+		}
+		if e < len(node.Entries) {
+			fields = append(fields, trees.EscapeDOTRecord(node.Entries[e].String()))
+		}
+	}
+	fmt.Fprintf(buffer, "\t%s [label=\"%s\"];\n", name, strings.Join(fields, "|"))
+	for e, child := range node.Children {
+		fmt.Fprintf(buffer, "\t%s:c%d -> n%d;\n", name, e, *id)
+		tree.dot(buffer, child, id)
+	}
+}
+
+func (tree *Tree) mermaid(buffer *bytes.Buffer, node *Node, id *int) {
+	name := fmt.Sprintf("n%d", *id)
+	*id++
+	keys := []string{}
+	for _, entry := range node.Entries {
+		keys = append(keys, trees.EscapeMermaid(entry.String()))
+	}
+	fmt.Fprintf(buffer, "\t%s[\"%s\"]\n", name, strings.Join(keys, " | "))
+	for _, child := range node.Children {
+		fmt.Fprintf(buffer, "\t%s --> n%d\n", name, *id)
+		tree.mermaid(buffer, child, id)
+	}
+}
+
 func (node *Node) height() int {
 	height := 0
 	for ; node != nil; node = node.Children[0] {
@@ -295,6 +554,70 @@ func (tree *Tree) search(node *Node, key interface{}) (index int, found bool) {
 	return low, false
 }
 
+// ceilingEntry searches down the tree for the smallest entry whose key is greater than (or, if inclusive, equal to)
+// the given key and returns the entry with its node, or nils if there is no such entry
+func (tree *Tree) ceilingEntry(key interface{}, inclusive bool) (*Node, *Entry) {
+	var ceilingNode *Node
+	var ceiling *Entry
+	for node := tree.Root; node != nil; {
+		index, found := tree.search(node, key)
+		if found {
+			if inclusive {
+				return node, node.Entries[index]
+			}
+			index++
+		}
+		if index < len(node.Entries) {
+			ceilingNode, ceiling = node, node.Entries[index]
+		}
+		if tree.isLeaf(node) {
+			break
+		}
+		node = node.Children[index]
+	}
+	return ceilingNode, ceiling
+}
+
+// floorEntry searches down the tree for the largest entry whose key is smaller than (or, if inclusive, equal to)
+// the given key and returns the entry with its node, or nils if there is no such entry
+func (tree *Tree) floorEntry(key interface{}, inclusive bool) (*Node, *Entry) {
+	var floorNode *Node
+	var floor *Entry
+	for node := tree.Root; node != nil; {
+		index, found := tree.search(node, key)
+		if found && inclusive {
+			return node, node.Entries[index]
+		}
+		if index > 0 {
+			floorNode, floor = node, node.Entries[index-1]
+		}
+		if tree.isLeaf(node) {
+			break
+		}
+		node = node.Children[index]
+	}
+	return floorNode, floor
+}
+
+// rank returns the number of keys smaller than the given key and whether the key is in the tree.
+func (tree *Tree) rank(key interface{}) (rank int, found bool) {
+	for node := tree.Root; node != nil; {
+		index, found := tree.search(node, key)
+		rank += index
+		if tree.isLeaf(node) {
+			return rank, found
+		}
+		for _, child := range node.Children[:index] {
+			rank += child.size
+		}
+		if found {
+			return rank + node.Children[index].size, true
+		}
+		node = node.Children[index]
+	}
+	return rank, false
+}
+
 // searchRecursively searches recursively down the tree starting at the startNode
 func (tree *Tree) searchRecursively(startNode *Node, key interface{}) (node *Node, index int, found bool) {
 	if tree.Empty() {
@@ -330,6 +653,9 @@ func (tree *Tree) insertIntoLeaf(node *Node, entry *Entry) (inserted bool) {
 	node.Entries = append(node.Entries, nil)
 	copy(node.Entries[insertPosition+1:], node.Entries[insertPosition:])
 	node.Entries[insertPosition] = entry
+	for parent := node; parent != nil; parent = parent.Parent {
+		parent.size++
+	}
 	tree.split(node)
 	return true
 }
@@ -370,6 +696,8 @@ func (tree *Tree) splitNonRoot(node *Node) {
 		setParent(left.Children, left)
 		setParent(right.Children, right)
 	}
+	left.updateSize()
+	right.updateSize()
 
 	insertPosition, _ := tree.search(parent, node.Entries[middle].Key)
 
@@ -404,9 +732,12 @@ func (tree *Tree) splitRoot() {
 	}
 
 	// Root is a node with one entry and two children (left and right)
+	left.updateSize()
+	right.updateSize()
 	newRoot := &Node{
 		Entries:  []*Entry{tree.Root.Entries[middle]},
 		Children: []*Node{left, right},
+		size:     tree.Root.size,
 	}
 
 	left.Parent = newRoot
@@ -414,6 +745,50 @@ func (tree *Tree) splitRoot() {
 	tree.Root = newRoot
 }
 
+// build returns a subtree of the sorted entries, whose height is one more than the number of the child capacities.
+// A subtree with n entries has n+1 slots for its children, which are spread evenly among the fewest children that can
+// hold them, so that every node has at least the minimum number of entries, since the subtree's height is minimal.
+func (tree *Tree) build(entries []*Entry, capacities []int, parent *Node) *Node {
+	node := &Node{Parent: parent, size: len(entries)}
+	if len(capacities) == 0 {
+		node.Entries = append([]*Entry(nil), entries...)
+		return node
+	}
+	slots := len(entries) + 1
+	capacity := capacities[len(capacities)-1]
+	children := (slots + capacity - 1) / capacity
+	node.Entries = make([]*Entry, 0, children-1)
+	node.Children = make([]*Node, 0, children)
+	for i := 0; i < children; i++ {
+		count := slots/children - 1 // entries in the child
+		if i < slots%children {
+			count++
+		}
+		node.Children = append(node.Children, tree.build(entries[:count], capacities[:len(capacities)-1], node))
+		entries = entries[count:]
+		if i < children-1 {
+			node.Entries = append(node.Entries, entries[0])
+			entries = entries[1:]
+		}
+	}
+	return node
+}
+
+// updateSize recomputes the size of the node from its entries and the sizes of its children.
+func (node *Node) updateSize() {
+	node.size = len(node.Entries)
+	for _, child := range node.Children {
+		node.size += child.size
+	}
+}
+
+// decrementSizes decrements the sizes of the node and its ancestors, i.e. accounts for an entry removed from the node.
+func decrementSizes(node *Node) {
+	for ; node != nil; node = node.Parent {
+		node.size--
+	}
+}
+
 func setParent(nodes []*Node, parent *Node) {
 	for _, node := range nodes {
 		node.Parent = parent
@@ -479,6 +854,7 @@ func (tree *Tree) delete(node *Node, index int) {
 	if tree.isLeaf(node) {
 		deletedKey := node.Entries[index].Key
 		tree.deleteEntry(node, index)
+		decrementSizes(node)
 		tree.rebalance(node, deletedKey)
 		if len(tree.Root.Entries) == 0 {
 			tree.Root = nil
@@ -492,6 +868,7 @@ func (tree *Tree) delete(node *Node, index int) {
 	node.Entries[index] = leftLargestNode.Entries[leftLargestEntryIndex]
 	deletedKey := leftLargestNode.Entries[leftLargestEntryIndex].Key
 	tree.deleteEntry(leftLargestNode, leftLargestEntryIndex)
+	decrementSizes(leftLargestNode)
 	tree.rebalance(leftLargestNode, deletedKey)
 }
 
@@ -516,6 +893,8 @@ func (tree *Tree) rebalance(node *Node, deletedKey interface{}) {
 			node.Children = append([]*Node{leftSiblingRightMostChild}, node.Children...)
 			tree.deleteChild(leftSibling, len(leftSibling.Children)-1)
 		}
+		node.updateSize()
+		leftSibling.updateSize()
 		return
 	}
 
@@ -532,6 +911,8 @@ func (tree *Tree) rebalance(node *Node, deletedKey interface{}) {
 			node.Children = append(node.Children, rightSiblingLeftMostChild)
 			tree.deleteChild(rightSibling, 0)
 		}
+		node.updateSize()
+		rightSibling.updateSize()
 		return
 	}
 
@@ -554,6 +935,7 @@ func (tree *Tree) rebalance(node *Node, deletedKey interface{}) {
 		tree.prependChildren(node.Parent.Children[leftSiblingIndex], node)
 		tree.deleteChild(node.Parent, leftSiblingIndex)
 	}
+	node.updateSize()
 
 	// make the merged node the root if its parent was the root and the root is empty
 	if node.Parent == tree.Root && len(tree.Root.Entries) == 0 {
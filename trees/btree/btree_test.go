@@ -6,7 +6,12 @@ package btree
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/trees"
+	"github.com/uncle-gua/gods/utils"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -697,6 +702,194 @@ func TestBTreeLeftAndRight(t *testing.T) {
 	}
 }
 
+func TestBTreeOrderStatistics(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	for _, key := range []int{12, 4, 20, 8, 16, 2, 10, 18, 6, 14} {
+		tree.Put(key, key)
+	}
+	tree.Remove(10)
+
+	tests1 := [][]interface{}{
+		{1, 0, -1},
+		{2, 0, 0},
+		{3, 1, -1},
+		{10, 4, -1},
+		{12, 4, 4},
+		{20, 8, 8},
+		{21, 9, -1},
+	}
+	for _, test := range tests1 {
+		if actualValue, expectedValue := tree.Rank(test[0]), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := tree.IndexOf(test[0]), test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	tests2 := [][]interface{}{
+		{-1, nil, false},
+		{0, 2, true},
+		{4, 12, true},
+		{8, 20, true},
+		{9, nil, false},
+	}
+	for _, test := range tests2 {
+		entry, found := tree.GetAt(test[0].(int))
+		var key interface{}
+		if found {
+			key = entry.Key
+		}
+		if actualValue, expectedValue := found, test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := key, test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	tests3 := [][]interface{}{
+		{0, 100, 9},
+		{4, 14, 4},
+		{13, 15, 1},
+		{5, 5, 0},
+		{14, 4, 0},
+	}
+	for _, test := range tests3 {
+		if actualValue, expectedValue := tree.CountRange(test[0], test[1]), test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestBTreeOrderStatisticsConsistency(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	for key := 0; key < 1000; key++ {
+		tree.Put(key, key)
+	}
+	for key := 0; key < 1000; key += 3 {
+		tree.Remove(key)
+	}
+	for index := 0; index < 666; index++ {
+		entry, found := tree.GetAt(index)
+		var key interface{}
+		if found {
+			key = entry.Key
+		}
+		if !found || tree.IndexOf(key) != index || tree.Rank(key) != index {
+			t.Errorf("Got %v expected %v", tree.IndexOf(key), index)
+		}
+	}
+}
+
+func TestBTreeLoadSorted(t *testing.T) {
+	for _, order := range []int{3, 4, 5, 7} {
+		for n := 0; n < 200; n++ {
+			keys, values := make([]interface{}, n), make([]interface{}, n)
+			for i := range keys {
+				keys[i], values[i] = i*2, fmt.Sprintf("v%d", i)
+			}
+			tree, err := NewFromSorted(order, utils.IntComparator, keys, values)
+			if err != nil {
+				t.Errorf("Got error %v", err)
+			}
+			assertBTree(t, tree)
+			if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), fmt.Sprintf("%v", keys); actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			if actualValue, expectedValue := fmt.Sprintf("%v", tree.Values()), fmt.Sprintf("%v", values); actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			for i := 0; i < n; i++ {
+				tree.Put(i*2+1, i)
+				tree.Remove(i * 2)
+			}
+			assertBTree(t, tree)
+			if actualValue, expectedValue := tree.Size(), n; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+	}
+
+	tree := NewWithIntComparator(3)
+	tree.Put(1, "a")
+	tests := [][]interface{}{
+		{[]interface{}{1, 3, 2}, []interface{}{"a", "b", "c"}, true},
+		{[]interface{}{1, 2, 2}, []interface{}{"a", "b", "c"}, true},
+		{[]interface{}{1, 2}, []interface{}{"a"}, false},
+	}
+	for _, test := range tests {
+		err := tree.LoadSorted(test[0].([]interface{}), test[1].([]interface{}))
+		if err == nil {
+			t.Errorf("Got no error for %v", test[0])
+		}
+		if actualValue, expectedValue := errors.Is(err, trees.ErrUnsorted), test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), "[1]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	source := NewWithStringComparator(3)
+	source.Put("c", 3)
+	source.Put("a", 1)
+	source.Put("b", 2)
+	it := source.Iterator()
+	it.Next()
+	tree, err := NewFromIterator(3, utils.StringComparator, &it)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	assertBTree(t, tree)
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), "[b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+// assertBTree checks the number of entries and children, the depth of the leaves, the parent links and the subtree
+// sizes of the tree.
+func assertBTree(t *testing.T, tree *Tree) {
+	t.Helper()
+	leafDepth := -1
+	var check func(node *Node, parent *Node, depth int) (size int)
+	check = func(node *Node, parent *Node, depth int) int {
+		if node.Parent != parent {
+			t.Errorf("Got parent %v expected %v", node.Parent, parent)
+		}
+		if len(node.Entries) > tree.maxEntries() || parent != nil && len(node.Entries) < tree.minEntries() {
+			t.Errorf("Got %v entries in node %v", len(node.Entries), node.Entries)
+		}
+		size := len(node.Entries)
+		if len(node.Children) == 0 {
+			if leafDepth == -1 {
+				leafDepth = depth
+			}
+			if depth != leafDepth {
+				t.Errorf("Got leaf depth %v expected %v", depth, leafDepth)
+			}
+		} else {
+			if len(node.Children) != len(node.Entries)+1 {
+				t.Errorf("Got %v children expected %v", len(node.Children), len(node.Entries)+1)
+			}
+			for _, child := range node.Children {
+				size += check(child, node, depth+1)
+			}
+		}
+		if node.size != size {
+			t.Errorf("Got size %v expected %v", node.size, size)
+		}
+		return size
+	}
+	size := 0
+	if tree.Root != nil {
+		size = check(tree.Root, nil, 0)
+	}
+	if size != tree.Size() {
+		t.Errorf("Got %v expected %v", size, tree.Size())
+	}
+}
+
 func TestBTreeIteratorValuesAndKeys(t *testing.T) {
 	tree := NewWithIntComparator(4)
 	tree.Put(4, "d")
@@ -1211,6 +1404,33 @@ func TestBTreeSerialization(t *testing.T) {
 	if err != nil {
 		t.Errorf("Got error %v", err)
 	}
+
+	err = tree.FromJSON([]byte(`{"c":3,"a":1,"e":5,"b":2,"d":4}`))
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	assertBTree(t, tree)
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), "[a b c d e]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBTreeSerializationEqualKeys(t *testing.T) {
+	tree := NewWith(3, utils.CaseInsensitiveStringComparator)
+	tree.Put("x", 0)
+	err := tree.FromJSON([]byte(`{"Apple":1,"apple":2,"banana":3}`))
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := tree.Keys(), []interface{}{"apple", "banana"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := tree.Values(), []interface{}{2.0, 3.0}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := tree.Get("APPLE"); actualValue != 2.0 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 2.0)
+	}
 }
 
 func TestBTreeString(t *testing.T) {
@@ -1221,6 +1441,275 @@ func TestBTreeString(t *testing.T) {
 	}
 }
 
+func TestBTreeIteratorConcurrentModification(t *testing.T) {
+	tree := NewWithStringComparator(3)
+	tree.Put("a", 1)
+	tree.Put("b", 2)
+	tree.Put("c", 3)
+
+	// modifications before the first element are allowed
+	it := tree.Iterator()
+	tree.Put("d", 4)
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, tree.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// modifications after Begin() or End() are allowed, moving in either direction accepts them
+	it.End()
+	tree.Put("f", 6)
+	if it.Next() {
+		t.Errorf("Should not iterate past the last element")
+	}
+	if !it.Prev() || it.Key() != "f" {
+		t.Errorf("Got %v expected %v", it.Key(), "f")
+	}
+	it.Begin()
+	tree.Remove("f")
+	if it.Prev() {
+		t.Errorf("Should not iterate before the first element")
+	}
+	if !it.Next() || it.Key() != "a" {
+		t.Errorf("Got %v expected %v", it.Key(), "a")
+	}
+
+	// modifications during iteration panic
+	func() {
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		it := tree.Iterator()
+		it.Next()
+		tree.Remove("a")
+		it.Next()
+	}()
+
+	// checked iterator stops and reports the error
+	checked := tree.CheckedIterator()
+	checked.Next()
+	tree.Put("e", 5)
+	if checked.Next() {
+		t.Errorf("Should not iterate after modification")
+	}
+	if actualValue, expectedValue := checked.Err(), containers.ErrConcurrentModification; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checked.Begin()
+	if actualValue := checked.Err(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	count = 0
+	for checked.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, tree.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBTreeIteratorModifiedAtBoundaries(t *testing.T) {
+	tree := NewWithStringComparator(3)
+	tree.Put("b", 0)
+	tree.Put("c", 0)
+	it := tree.Iterator()
+	it.Next()
+
+	// moving from Begin() or End() after a modification starts over on the modified container
+	tests := [][]interface{}{
+		{true, true, "a", "a"},
+		{false, true, "d", "d"},
+		{true, false, "a", "b"},
+		{false, false, "d", "c"},
+	}
+	for _, test := range tests {
+		forward, put, key, expectedValue := test[0].(bool), test[1].(bool), test[2], test[3]
+		if forward {
+			it.Begin()
+		} else {
+			it.End()
+		}
+		if put {
+			tree.Put(key, 0)
+		} else {
+			tree.Remove(key)
+		}
+		moved := false
+		if forward {
+			moved = it.Next()
+		} else {
+			moved = it.Prev()
+		}
+		if !moved {
+			t.Errorf("Got %v expected %v", moved, true)
+			continue
+		}
+		if actualValue := it.Key(); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestBTreeIteratorClone(t *testing.T) {
+	tree := NewWithStringComparator(3)
+	tree.Put("a", 1)
+	tree.Put("b", 2)
+	tree.Put("c", 3)
+
+	it := tree.Iterator()
+	it.Next()
+	clone := it.Clone()
+	first := it.Key()
+	it.Next()
+	if actualValue, expectedValue := clone.Key(), first; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !clone.Next() || clone.Key() != it.Key() {
+		t.Errorf("Got %v expected %v", clone.Key(), it.Key())
+	}
+	clone.Next()
+	if clone.Next() {
+		t.Errorf("Should not go past the last element")
+	}
+	if !it.Next() {
+		t.Errorf("Should move independently of the clone")
+	}
+}
+
+func TestBTreeIteratorSeek(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	for i := 2; i <= 20; i += 2 {
+		tree.Put(i, i)
+	}
+	it := tree.Iterator()
+	tests := [][]interface{}{
+		{it.SeekGE, 4, true, 4},
+		{it.SeekGE, 5, true, 6},
+		{it.SeekGE, 0, true, 2},
+		{it.SeekGE, 21, false, nil},
+		{it.SeekGT, 4, true, 6},
+		{it.SeekGT, 20, false, nil},
+		{it.SeekLE, 4, true, 4},
+		{it.SeekLE, 5, true, 4},
+		{it.SeekLE, 1, false, nil},
+		{it.SeekLT, 4, true, 2},
+		{it.SeekLT, 100, true, 20},
+		{it.SeekLT, 2, false, nil},
+	}
+	for _, test := range tests {
+		found := test[0].(func(interface{}) bool)(test[1])
+		if actualValue, expectedValue := found, test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if found && it.Key() != test[3] {
+			t.Errorf("Got %v expected %v", it.Key(), test[3])
+		}
+	}
+
+	// iteration continues from the sought element in both directions
+	it.SeekGE(5)
+	if !it.Next() || it.Key() != 8 {
+		t.Errorf("Got %v expected %v", it.Key(), 8)
+	}
+	it.SeekGE(5)
+	if !it.Prev() || it.Key() != 4 {
+		t.Errorf("Got %v expected %v", it.Key(), 4)
+	}
+	it.SeekGT(20)
+	if !it.Prev() || it.Key() != 20 {
+		t.Errorf("Got %v expected %v", it.Key(), 20)
+	}
+	it.SeekLT(2)
+	if !it.Next() || it.Key() != 2 {
+		t.Errorf("Got %v expected %v", it.Key(), 2)
+	}
+}
+
+func TestBTreeRangeIterator(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	for i := 2; i <= 20; i += 2 {
+		tree.Put(i, i)
+	}
+	it := tree.RangeIterator(5, 15)
+	keys := []interface{}{}
+	for it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[6 8 10 12 14]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	keys = []interface{}{}
+	for it.Prev() {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[14 12 10 8 6]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tests := [][]interface{}{
+		{it.First, 6},
+		{it.Last, 14},
+		{func() bool { return it.SeekGE(1) }, 6},
+		{func() bool { return it.SeekGT(10) }, 12},
+		{func() bool { return it.SeekLE(100) }, 14},
+		{func() bool { return it.SeekLT(6) }, nil},
+		{func() bool { return it.SeekGE(15) }, nil},
+	}
+	for _, test := range tests {
+		found := test[0].(func() bool)()
+		if actualValue, expectedValue := found, test[1] != nil; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if found && it.Key() != test[1] {
+			t.Errorf("Got %v expected %v", it.Key(), test[1])
+		}
+	}
+}
+
+func TestBTreeSeq(t *testing.T) {
+	tree := NewWithStringComparator(3)
+	tree.Put("c", 3)
+	tree.Put("a", 1)
+	tree.Put("b", 2)
+
+	pairs := []string{}
+	for key, value := range tree.AllSeq() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "a:1 b:2 c:3"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(tree.KeysSeq())), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(tree.ValuesSeq())), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	pairs = []string{}
+	for key, value := range tree.Backward() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "c:3 b:2 a:1"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	count := 0
+	for key := range tree.KeysSeq() {
+		if key == "b" {
+			break
+		}
+		count++
+	}
+	if actualValue, expectedValue := count, 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func benchmarkGet(b *testing.B, tree *Tree, size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -1373,3 +1862,81 @@ func BenchmarkBTreeRemove100000(b *testing.B) {
 	b.StartTimer()
 	benchmarkRemove(b, tree, size)
 }
+
+func TestBTreeValidate(t *testing.T) {
+	tests := [][]interface{}{
+		{func(tree *Tree) {}, ""},
+		{func(tree *Tree) {
+			tree.Root.Children[0].Entries = append(tree.Root.Children[0].Entries, tree.Root.Entries[0], tree.Root.Entries[0])
+		}, "node [2 4 4] has 3 entries, more than the maximum 2"},
+		{func(tree *Tree) { tree.Root.Children[0].Entries = nil }, "node [] has 0 entries, fewer than the minimum 1"},
+		{func(tree *Tree) { tree.Root.Children[0].Children = tree.Root.Children[0].Children[:1] }, "node [2] has 1 children instead of 2"},
+		{func(tree *Tree) { tree.Root.Children[1].Children = nil }, "leaf [6] is at depth 1 instead of 2"},
+		{func(tree *Tree) { tree.Root.Children[1].Parent = nil }, "node [6] does not link to its parent [4]"},
+		{func(tree *Tree) { tree.Root.Children[0].Entries[0].Key = 5 }, "key 3 of node [3] is not greater than its predecessor 5"},
+		{func(tree *Tree) { tree.Root.size = 6 }, "node [4] has size 6 instead of 7"},
+		{func(tree *Tree) { tree.size = 8 }, "tree has size 8 instead of 7"},
+	}
+	for _, test := range tests {
+		tree := NewWithIntComparator(3)
+		for key := 1; key <= 7; key++ {
+			tree.Put(key, key)
+		}
+		test[0].(func(*Tree))(tree)
+		err := tree.Validate()
+		if test[1] == "" {
+			if err != nil {
+				t.Errorf("Got %v expected %v", err, nil)
+			}
+			continue
+		}
+		if !errors.Is(err, containers.ErrInvalid) {
+			t.Errorf("Got %v expected %v", err, containers.ErrInvalid)
+		}
+		if err != nil && !strings.HasSuffix(err.Error(), test[1].(string)) {
+			t.Errorf("Got %v expected %v", err, test[1])
+		}
+	}
+}
+
+func TestBTreeToDOT(t *testing.T) {
+	tree := NewWithStringComparator(3)
+	if actualValue, expectedValue := tree.ToDOT(), "digraph BTree {\n\tnode [shape=record];\n}\n"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for _, key := range []string{"a", "b", "c", "d", "{e|f}"} {
+		tree.Put(key, key)
+	}
+	expectedValue := "digraph BTree {\n" +
+		"\tnode [shape=record];\n" +
+		"\tn0 [label=\"<c0>|b|<c1>|d|<c2>\"];\n" +
+		"\tn0:c0 -> n1;\n" +
+		"\tn1 [label=\"a\"];\n" +
+		"\tn0:c1 -> n2;\n" +
+		"\tn2 [label=\"c\"];\n" +
+		"\tn0:c2 -> n3;\n" +
+		"\tn3 [label=\"\\{e\\|f\\}\"];\n" +
+		"}\n"
+	if actualValue := tree.ToDOT(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBTreeToMermaid(t *testing.T) {
+	tree := NewWithStringComparator(3)
+	if actualValue, expectedValue := tree.ToMermaid(), "graph TD\n"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for _, key := range []string{"a", "b", "c", "d", "<e>"} {
+		tree.Put(key, key)
+	}
+	expectedValue := "graph TD\n" +
+		"\tn0[\"b\"]\n" +
+		"\tn0 --> n1\n" +
+		"\tn1[\"#lt;e#gt; | a\"]\n" +
+		"\tn0 --> n2\n" +
+		"\tn2[\"c | d\"]\n"
+	if actualValue := tree.ToMermaid(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
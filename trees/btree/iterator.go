@@ -4,7 +4,11 @@
 
 package btree
 
-import "github.com/uncle-gua/gods/containers"
+import (
+	"iter"
+
+	"github.com/uncle-gua/gods/containers"
+)
 
 // Assert Iterator implementation
 var _ containers.ReverseIteratorWithKey = (*Iterator)(nil)
@@ -15,6 +19,9 @@ type Iterator struct {
 	node     *Node
 	entry    *Entry
 	position position
+	bounded  bool
+	from, to interface{}
+	failFast containers.FailFast
 }
 
 type position byte
@@ -24,8 +31,29 @@ const (
 )
 
 // Iterator returns a stateful iterator whose elements are key/value pairs.
+// The iterator fails fast: moving it after the tree was structurally modified other than through the iterator
+// panics with containers.ErrConcurrentModification. Modifications made while the iterator is before the first
+// or past the last element, e.g. after Begin() or End(), are allowed.
 func (tree *Tree) Iterator() Iterator {
-	return Iterator{tree: tree, node: nil, position: begin}
+	return Iterator{tree: tree, node: nil, position: begin, failFast: containers.NewFailFast(tree.modCount)}
+}
+
+// CheckedIterator returns a stateful iterator like Iterator(), except that instead of panicking when the tree was
+// modified during iteration, it stops and reports containers.ErrConcurrentModification by Err().
+func (tree *Tree) CheckedIterator() Iterator {
+	iterator := tree.Iterator()
+	iterator.failFast.Check()
+	return iterator
+}
+
+// RangeIterator returns a stateful iterator like Iterator(), restricted to the elements whose keys are in the
+// half-open range [from, to), i.e. it moves from its initial state to the first element not smaller than from,
+// and past its last element when the next element would not be smaller than to (and vice versa in reverse).
+// Keys should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) RangeIterator(from, to interface{}) Iterator {
+	iterator := tree.Iterator()
+	iterator.bounded, iterator.from, iterator.to = true, from, to
+	return iterator
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -33,18 +61,21 @@ func (tree *Tree) Iterator() Iterator {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator) Next() bool {
+	if !iterator.failFast.Move(iterator.tree.modCount, iterator.position != between) {
+		return false
+	}
 	// If already at end, go to end
 	if iterator.position == end {
 		goto end
 	}
-	// If at beginning, get the left-most entry in the tree
+	// If at beginning, get the left-most entry in the tree (or range)
 	if iterator.position == begin {
-		left := iterator.tree.Left()
+		left, entry := iterator.first()
 		if left == nil {
 			goto end
 		}
 		iterator.node = left
-		iterator.entry = left.Entries[0]
+		iterator.entry = entry
 		goto between
 	}
 	{
@@ -84,6 +115,9 @@ end:
 	return false
 
 between:
+	if !iterator.withinBounds(iterator.entry.Key) {
+		goto end
+	}
 	iterator.position = between
 	return true
 }
@@ -92,18 +126,21 @@ between:
 // If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator) Prev() bool {
+	if !iterator.failFast.Move(iterator.tree.modCount, iterator.position != between) {
+		return false
+	}
 	// If already at beginning, go to begin
 	if iterator.position == begin {
 		goto begin
 	}
-	// If at end, get the right-most entry in the tree
+	// If at end, get the right-most entry in the tree (or range)
 	if iterator.position == end {
-		right := iterator.tree.Right()
+		right, entry := iterator.last()
 		if right == nil {
 			goto begin
 		}
 		iterator.node = right
-		iterator.entry = right.Entries[len(right.Entries)-1]
+		iterator.entry = entry
 		goto between
 	}
 	{
@@ -143,6 +180,9 @@ begin:
 	return false
 
 between:
+	if !iterator.withinBounds(iterator.entry.Key) {
+		goto begin
+	}
 	iterator.position = between
 	return true
 }
@@ -165,12 +205,20 @@ func (iterator *Iterator) Node() *Node {
 	return iterator.node
 }
 
+// Clone returns an independent copy of the iterator at the same position, e.g. for lookahead or backtracking.
+// Moving either of the iterators does not move the other one.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Clone() Iterator {
+	return *iterator
+}
+
 // Begin resets the iterator to its initial state (one-before-first)
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator) Begin() {
 	iterator.node = nil
 	iterator.position = begin
 	iterator.entry = nil
+	iterator.sync()
 }
 
 // End moves the iterator past the last element (one-past-the-end).
@@ -179,6 +227,7 @@ func (iterator *Iterator) End() {
 	iterator.node = nil
 	iterator.position = end
 	iterator.entry = nil
+	iterator.sync()
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -224,3 +273,151 @@ func (iterator *Iterator) PrevTo(f func(key interface{}, value interface{}) bool
 	}
 	return false
 }
+
+// SeekGE moves the iterator to the first element whose key is greater than or equal to the given key and returns
+// true if there was such an element, otherwise moves the iterator past the last element.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekGE(key interface{}) bool {
+	return iterator.seekForward(iterator.tree.ceilingEntry(key, true))
+}
+
+// SeekGT moves the iterator to the first element whose key is greater than the given key and returns
+// true if there was such an element, otherwise moves the iterator past the last element.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekGT(key interface{}) bool {
+	return iterator.seekForward(iterator.tree.ceilingEntry(key, false))
+}
+
+// SeekLE moves the iterator to the last element whose key is smaller than or equal to the given key and returns
+// true if there was such an element, otherwise moves the iterator before the first element.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekLE(key interface{}) bool {
+	return iterator.seekBackward(iterator.tree.floorEntry(key, true))
+}
+
+// SeekLT moves the iterator to the last element whose key is smaller than the given key and returns
+// true if there was such an element, otherwise moves the iterator before the first element.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekLT(key interface{}) bool {
+	return iterator.seekBackward(iterator.tree.floorEntry(key, false))
+}
+
+// seekForward moves the iterator to the entry, or to the first entry if the entry is below the range of the iterator.
+func (iterator *Iterator) seekForward(node *Node, entry *Entry) bool {
+	if entry != nil && iterator.bounded && iterator.tree.Comparator(entry.Key, iterator.from) < 0 {
+		node, entry = iterator.first()
+	}
+	if entry == nil || !iterator.withinBounds(entry.Key) {
+		iterator.End()
+		return false
+	}
+	iterator.sync()
+	iterator.node, iterator.entry, iterator.position = node, entry, between
+	return true
+}
+
+// seekBackward moves the iterator to the entry, or to the last entry if the entry is above the range of the iterator.
+func (iterator *Iterator) seekBackward(node *Node, entry *Entry) bool {
+	if entry != nil && iterator.bounded && iterator.tree.Comparator(entry.Key, iterator.to) >= 0 {
+		node, entry = iterator.last()
+	}
+	if entry == nil || !iterator.withinBounds(entry.Key) {
+		iterator.Begin()
+		return false
+	}
+	iterator.sync()
+	iterator.node, iterator.entry, iterator.position = node, entry, between
+	return true
+}
+
+// first returns the first entry within the range of the iterator with its node.
+func (iterator *Iterator) first() (*Node, *Entry) {
+	if iterator.bounded {
+		return iterator.tree.ceilingEntry(iterator.from, true)
+	}
+	left := iterator.tree.Left()
+	if left == nil {
+		return nil, nil
+	}
+	return left, left.Entries[0]
+}
+
+// last returns the last entry within the range of the iterator with its node.
+func (iterator *Iterator) last() (*Node, *Entry) {
+	if iterator.bounded {
+		return iterator.tree.floorEntry(iterator.to, false)
+	}
+	right := iterator.tree.Right()
+	if right == nil {
+		return nil, nil
+	}
+	return right, right.Entries[len(right.Entries)-1]
+}
+
+// withinBounds returns true if the key is within the range of the iterator.
+func (iterator *Iterator) withinBounds(key interface{}) bool {
+	return !iterator.bounded ||
+		iterator.tree.Comparator(key, iterator.from) >= 0 && iterator.tree.Comparator(key, iterator.to) < 0
+}
+
+// Err returns containers.ErrConcurrentModification if the checked iterator stopped because the tree was modified
+// during iteration, otherwise nil.
+func (iterator *Iterator) Err() error {
+	return iterator.failFast.Err()
+}
+
+// sync accepts the current state of the tree, i.e. after the iterator was reset.
+func (iterator *Iterator) sync() {
+	iterator.failFast.Sync(iterator.tree.modCount)
+}
+
+// AllSeq returns an iterator over key-value pairs of the tree in order, for use with range-over-func.
+func (tree *Tree) AllSeq() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		iterator := tree.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Key(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns an iterator over keys of the tree in order, for use with range-over-func.
+// It is not named Keys, which returns the keys as a slice.
+func (tree *Tree) KeysSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		iterator := tree.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Key()) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over values of the tree in order of their keys, for use with range-over-func.
+// It is not named Values, which returns the values as a slice.
+func (tree *Tree) ValuesSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		iterator := tree.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over key-value pairs of the tree in reverse order, for use with range-over-func.
+func (tree *Tree) Backward() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		iterator := tree.Iterator()
+		iterator.End()
+		for iterator.Prev() {
+			if !yield(iterator.Key(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
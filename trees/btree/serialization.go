@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"github.com/uncle-gua/gods/containers"
 	"github.com/uncle-gua/gods/utils"
+	"sort"
 )
 
 // Assert Serialization implementation
@@ -25,16 +26,34 @@ func (tree *Tree) ToJSON() ([]byte, error) {
 }
 
 // FromJSON populates the tree from the input JSON representation.
+// The keys are sorted and the tree is built from them directly, without rebalancing.
+// Of the keys that are equal with respect to the comparator, only the last one in byte order is kept.
 func (tree *Tree) FromJSON(data []byte) error {
 	elements := make(map[string]interface{})
 	err := json.Unmarshal(data, &elements)
-	if err == nil {
-		tree.Clear()
-		for key, value := range elements {
-			tree.Put(key, value)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(elements))
+	for key := range elements {
+		names = append(names, key)
+	}
+	sort.Strings(names)
+	sorted := make([]interface{}, len(names))
+	for i, name := range names {
+		sorted[i] = name
+	}
+	utils.SortStable(sorted, tree.Comparator)
+	// keys the comparator considers equal are collapsed, the last one in byte order wins
+	keys, values := sorted[:0], make([]interface{}, 0, len(sorted))
+	for _, key := range sorted {
+		if last := len(keys) - 1; last >= 0 && tree.Comparator(keys[last], key) == 0 {
+			keys[last], values[last] = key, elements[key.(string)]
+			continue
 		}
+		keys, values = append(keys, key), append(values, elements[key.(string)])
 	}
-	return err
+	return tree.LoadSorted(keys, values)
 }
 
 // UnmarshalJSON @implements json.Unmarshaler
@@ -0,0 +1,65 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package diskbtree
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+)
+
+// Codec converts keys or values to the bytes stored in the pages and back.
+type Codec interface {
+	// Encode returns the bytes representing the value.
+	Encode(value interface{}) ([]byte, error)
+	// Decode returns the value represented by the bytes.
+	Decode(data []byte) (interface{}, error)
+}
+
+// IntCodec encodes values of type int as varints.
+var IntCodec Codec = intCodec{}
+
+// StringCodec encodes values of type string as their bytes.
+var StringCodec Codec = stringCodec{}
+
+// JSONCodec encodes values of any type as JSON.
+// Decoded values have the types produced by encoding/json, e.g. numbers are decoded as float64.
+var JSONCodec Codec = jsonCodec{}
+
+type intCodec struct{}
+
+func (intCodec) Encode(value interface{}) ([]byte, error) {
+	return binary.AppendVarint(nil, int64(value.(int))), nil
+}
+
+func (intCodec) Decode(data []byte) (interface{}, error) {
+	value, n := binary.Varint(data)
+	if n <= 0 || n != len(data) {
+		return nil, errors.New("diskbtree: invalid int")
+	}
+	return int(value), nil
+}
+
+type stringCodec struct{}
+
+func (stringCodec) Encode(value interface{}) ([]byte, error) {
+	return []byte(value.(string)), nil
+}
+
+func (stringCodec) Decode(data []byte) (interface{}, error) {
+	return string(data), nil
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Encode(value interface{}) ([]byte, error) {
+	return json.Marshal(value)
+}
+
+func (jsonCodec) Decode(data []byte) (interface{}, error) {
+	var value interface{}
+	err := json.Unmarshal(data, &value)
+	return value, err
+}
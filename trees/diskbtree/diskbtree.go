@@ -0,0 +1,694 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package diskbtree implements a B tree stored in a file.
+//
+// The tree has the same properties and key/value methods as the in-memory btree.Tree, but each node is stored in
+// a fixed-size page of a single file. Nodes are loaded on demand and at most a given number of them is kept in
+// memory, evicting the least recently used ones and writing them if they were modified. Keys and values are
+// converted to bytes by codecs.
+//
+// Modifications become durable when they are committed, which writes the modified nodes, syncs the file and then
+// writes and syncs a meta page pointing to the new root. Pages of the last commit are never overwritten
+// (copy-on-write), and the meta page alternates between two slots protected by checksums, so that the tree reopens
+// in the state of the last complete commit after a crash, e.g. after a truncated write.
+//
+// Modifications that fail to read or write the file are reported by Err(), after which the tree does not modify
+// the file anymore and has to be reopened to recover the last commit.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/B-tree
+package diskbtree
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/uncle-gua/gods/trees"
+	"github.com/uncle-gua/gods/utils"
+	"os"
+	"strings"
+)
+
+// Assert Tree implementation
+var _ trees.Tree = (*Tree)(nil)
+
+// Tree holds elements of the B-tree stored in a file
+type Tree struct {
+	Comparator utils.Comparator // Key comparator
+	KeyCodec   Codec            // Key codec
+	ValueCodec Codec            // Value codec
+	file       file             // file holding the pages
+	pageSize   int              // size of the pages in bytes
+	m          int              // order (maximum number of children)
+	meta       meta             // state of the last commit
+	slot       int              // meta page holding the last commit
+	root       uint64           // page of the root node or 0 if the tree is empty
+	size       int              // total number of keys in the tree
+	pageCount  uint64           // number of pages in the file
+	free       []uint64         // pages that can be reused
+	pending    []uint64         // pages of the last commit that can be reused after the next commit
+	fresh      map[uint64]bool  // pages allocated since the last commit, which can be modified in place
+	cache      map[uint64]*node // nodes kept in memory by page
+	cacheSize  int              // maximum number of nodes kept in memory between operations
+	newest     *node            // most recently used node in the cache
+	oldest     *node            // least recently used node in the cache
+	modCount   int              // number of modifications, checked by iterators, since any modification may move nodes to new pages
+	err        error            // first error reading or writing the file
+}
+
+// Entry represents the key-value pair contained within nodes
+type Entry struct {
+	Key   interface{}
+	Value interface{}
+}
+
+// Options configures the storage of the tree.
+type Options struct {
+	PageSize  int // size of the pages in bytes, 4096 if zero
+	CacheSize int // maximum number of nodes kept in memory, 256 if zero
+}
+
+const (
+	defaultPageSize  = 4096
+	defaultCacheSize = 256
+)
+
+// Open opens the tree stored in the file at the path, or creates the file if it does not exist.
+// The tree has the order (maximum number of children) and the page size it was created with, which have to match
+// the given ones. Keys are ordered by the comparator and converted to bytes by the key codec, values by the value codec.
+// If options are nil, the defaults are used.
+func Open(path string, order int, comparator utils.Comparator, keyCodec Codec, valueCodec Codec, options *Options) (*Tree, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := open(file, order, comparator, keyCodec, valueCodec, options)
+	if err != nil {
+		if closeErr := file.Close(); closeErr != nil {
+			return nil, errors.Join(err, closeErr)
+		}
+		return nil, err
+	}
+	return tree, nil
+}
+
+func open(file file, order int, comparator utils.Comparator, keyCodec Codec, valueCodec Codec, options *Options) (*Tree, error) {
+	if order < 3 {
+		return nil, fmt.Errorf("diskbtree: invalid order %d, should be at least 3", order)
+	}
+	if options == nil {
+		options = &Options{}
+	}
+	tree := &Tree{
+		Comparator: comparator,
+		KeyCodec:   keyCodec,
+		ValueCodec: valueCodec,
+		file:       file,
+		pageSize:   options.PageSize,
+		m:          order,
+		fresh:      make(map[uint64]bool),
+		cache:      make(map[uint64]*node),
+		cacheSize:  options.CacheSize,
+	}
+	if tree.pageSize == 0 {
+		tree.pageSize = defaultPageSize
+	}
+	if tree.cacheSize == 0 {
+		tree.cacheSize = defaultCacheSize
+	}
+	if tree.pageSize < metaSize || tree.MaxEntrySize() <= 0 {
+		return nil, fmt.Errorf("diskbtree: page size %d is too small for order %d", tree.pageSize, order)
+	}
+	if err := tree.reopen(order, tree.pageSize); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+// MaxEntrySize returns the maximum total length of an encoded key and value, so that a full node fits into a page.
+func (tree *Tree) MaxEntrySize() int {
+	// header, flag, number of entries and children, then the lengths of the key and value of each entry
+	available := tree.pageSize - nodeHeader - 1 - binary.MaxVarintLen64*(tree.m+1)
+	return available/tree.maxEntries() - 2*binary.MaxVarintLen64
+}
+
+// Put inserts key-value pair node into the tree.
+// If key already exists, then its value is updated with the new value.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+// Reports ErrEntryTooLarge by Err() if the encoded key and value are longer than MaxEntrySize().
+func (tree *Tree) Put(key interface{}, value interface{}) {
+	if tree.err != nil {
+		return
+	}
+	defer tree.evict()
+	if err := tree.check(key, value); err != nil {
+		tree.fail(err)
+		return
+	}
+	entry := &Entry{Key: key, Value: value}
+
+	if tree.root == 0 {
+		// Assert key is of comparator's type for initial tree
+		tree.Comparator(key, key)
+		root := &node{id: tree.allocate(), entries: []*Entry{entry}}
+		tree.store(root)
+		tree.root = root.id
+		tree.size++
+		tree.modCount++
+		return
+	}
+
+	root, err := tree.writableRoot()
+	if err != nil {
+		tree.fail(err)
+		return
+	}
+	inserted, err := tree.insert(root, entry)
+	if err != nil {
+		tree.fail(err)
+		return
+	}
+	if tree.shouldSplit(root) {
+		right, middle := tree.split(root)
+		root = &node{id: tree.allocate(), entries: []*Entry{middle}, children: []uint64{root.id, right.id}}
+		tree.store(root)
+		tree.root = root.id
+	}
+	if inserted {
+		tree.size++
+	}
+	tree.modCount++
+}
+
+// Get searches the node in the tree by key and returns its value or nil if key is not found in tree.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Get(key interface{}) (value interface{}, found bool) {
+	defer tree.evict()
+	entry, err := tree.lookup(key)
+	if err != nil {
+		tree.fail(err)
+		return nil, false
+	}
+	if entry == nil {
+		return nil, false
+	}
+	return entry.Value, true
+}
+
+// Remove remove the node from the tree by key.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Remove(key interface{}) {
+	if tree.err != nil {
+		return
+	}
+	defer tree.evict()
+	// Nodes are only copied on write if the key exists
+	if entry, err := tree.lookup(key); err != nil || entry == nil {
+		tree.fail(err)
+		return
+	}
+	root, err := tree.writableRoot()
+	if err != nil {
+		tree.fail(err)
+		return
+	}
+	if err := tree.delete(root, key); err != nil {
+		tree.fail(err)
+		return
+	}
+	if len(root.entries) == 0 {
+		if root.leaf() {
+			tree.root = 0
+		} else {
+			tree.root = root.children[0]
+		}
+		tree.release(root)
+	}
+	tree.size--
+	tree.modCount++
+}
+
+// Empty returns true if tree does not contain any nodes
+func (tree *Tree) Empty() bool {
+	return tree.size == 0
+}
+
+// Size returns number of nodes in the tree.
+func (tree *Tree) Size() int {
+	return tree.size
+}
+
+// Keys returns all keys in-order
+func (tree *Tree) Keys() []interface{} {
+	keys := make([]interface{}, 0, tree.size)
+	it := tree.Iterator()
+	for it.Next() {
+		keys = append(keys, it.Key())
+	}
+	return keys
+}
+
+// Values returns all values in-order based on the key.
+func (tree *Tree) Values() []interface{} {
+	values := make([]interface{}, 0, tree.size)
+	it := tree.Iterator()
+	for it.Next() {
+		values = append(values, it.Value())
+	}
+	return values
+}
+
+// Clear removes all nodes from the tree.
+// The pages of the last commit are reused after the next commit.
+func (tree *Tree) Clear() {
+	if tree.err != nil {
+		return
+	}
+	free := make(map[uint64]bool, len(tree.free))
+	for _, id := range tree.free {
+		free[id] = true
+	}
+	tree.pending = tree.pending[:0]
+	for id := uint64(metaPages); id < tree.pageCount; id++ {
+		if !free[id] && !tree.fresh[id] {
+			tree.pending = append(tree.pending, id)
+		}
+	}
+	for id := range tree.fresh {
+		tree.free = append(tree.free, id)
+	}
+	tree.fresh = make(map[uint64]bool)
+	tree.cache = make(map[uint64]*node)
+	tree.newest, tree.oldest = nil, nil
+	tree.root = 0
+	tree.size = 0
+	tree.modCount++
+}
+
+// Height returns the height of the tree.
+func (tree *Tree) Height() int {
+	defer tree.evict()
+	height := 0
+	for id := tree.root; id != 0; height++ {
+		node, err := tree.load(id)
+		if err != nil {
+			tree.fail(err)
+			return 0
+		}
+		if node.leaf() {
+			id = 0
+		} else {
+			id = node.children[0]
+		}
+	}
+	return height
+}
+
+// LeftKey returns the left-most (min) key or nil if tree is empty.
+func (tree *Tree) LeftKey() interface{} {
+	if it := tree.Iterator(); it.First() {
+		return it.Key()
+	}
+	return nil
+}
+
+// LeftValue returns the left-most value or nil if tree is empty.
+func (tree *Tree) LeftValue() interface{} {
+	if it := tree.Iterator(); it.First() {
+		return it.Value()
+	}
+	return nil
+}
+
+// RightKey returns the right-most (max) key or nil if tree is empty.
+func (tree *Tree) RightKey() interface{} {
+	if it := tree.Iterator(); it.Last() {
+		return it.Key()
+	}
+	return nil
+}
+
+// RightValue returns the right-most value or nil if tree is empty.
+func (tree *Tree) RightValue() interface{} {
+	if it := tree.Iterator(); it.Last() {
+		return it.Value()
+	}
+	return nil
+}
+
+// Commit makes the modifications since the last commit durable, i.e. writes the modified nodes and syncs the file.
+// Returns the error which occurred since the last commit, if any.
+func (tree *Tree) Commit() error {
+	if tree.err != nil {
+		return tree.err
+	}
+	if err := tree.commit(); err != nil {
+		tree.fail(err)
+		return err
+	}
+	return nil
+}
+
+// Close commits the modifications and closes the file.
+func (tree *Tree) Close() error {
+	if tree.err == ErrClosed {
+		return ErrClosed
+	}
+	err := tree.Commit()
+	if closeErr := tree.file.Close(); err == nil {
+		err = closeErr
+	}
+	tree.err = ErrClosed
+	tree.cache = make(map[uint64]*node)
+	tree.newest, tree.oldest = nil, nil
+	return err
+}
+
+// Err returns the first error which occurred reading or writing the file or encoding the entries, or ErrClosed
+// after the tree was closed. After an error, the tree does not modify the file anymore; reopen the file to
+// continue from the last commit.
+func (tree *Tree) Err() error {
+	return tree.err
+}
+
+// String returns a string representation of container (for debugging purposes)
+func (tree *Tree) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("DiskBTree\n")
+	if !tree.Empty() {
+		tree.output(&buffer, tree.root, 0)
+		tree.evict()
+	}
+	return buffer.String()
+}
+
+func (entry *Entry) String() string {
+	return fmt.Sprintf("%v", entry.Key)
+}
+
+func (tree *Tree) output(buffer *bytes.Buffer, id uint64, level int) {
+	node, err := tree.load(id)
+	if err != nil {
+		tree.fail(err)
+		return
+	}
+	for e := 0; e < len(node.entries)+1; e++ {
+		if e < len(node.children) {
+			tree.output(buffer, node.children[e], level+1)
+		}
+		if e < len(node.entries) {
+			buffer.WriteString(strings.Repeat("    ", level))
+			buffer.WriteString(node.entries[e].String() + "\n")
+		}
+	}
+}
+
+// fail records the first error.
+func (tree *Tree) fail(err error) {
+	if err != nil && tree.err == nil {
+		tree.err = err
+	}
+}
+
+// check returns ErrEntryTooLarge if the encoded key and value do not fit into a node.
+func (tree *Tree) check(key interface{}, value interface{}) error {
+	encodedKey, err := tree.KeyCodec.Encode(key)
+	if err != nil {
+		return err
+	}
+	encodedValue, err := tree.ValueCodec.Encode(value)
+	if err != nil {
+		return err
+	}
+	if len(encodedKey)+len(encodedValue) > tree.MaxEntrySize() {
+		return ErrEntryTooLarge
+	}
+	return nil
+}
+
+func (tree *Tree) maxChildren() int {
+	return tree.m
+}
+
+func (tree *Tree) minChildren() int {
+	return (tree.m + 1) / 2 // ceil(m/2)
+}
+
+func (tree *Tree) maxEntries() int {
+	return tree.maxChildren() - 1
+}
+
+func (tree *Tree) minEntries() int {
+	return tree.minChildren() - 1
+}
+
+func (tree *Tree) shouldSplit(node *node) bool {
+	return len(node.entries) > tree.maxEntries()
+}
+
+// search searches only within the single node among its entries
+func (tree *Tree) search(node *node, key interface{}) (index int, found bool) {
+	low, high := 0, len(node.entries)-1
+	var mid int
+	for low <= high {
+		mid = (high + low) / 2
+		compare := tree.Comparator(key, node.entries[mid].Key)
+		switch {
+		case compare > 0:
+			low = mid + 1
+		case compare < 0:
+			high = mid - 1
+		case compare == 0:
+			return mid, true
+		}
+	}
+	return low, false
+}
+
+// lookup returns the entry of the key or nil if key is not found in tree.
+func (tree *Tree) lookup(key interface{}) (*Entry, error) {
+	for id := tree.root; id != 0; {
+		node, err := tree.load(id)
+		if err != nil {
+			return nil, err
+		}
+		index, found := tree.search(node, key)
+		if found {
+			return node.entries[index], nil
+		}
+		if node.leaf() {
+			return nil, nil
+		}
+		id = node.children[index]
+	}
+	return nil, nil
+}
+
+// writableRoot loads the root and prepares it for modification.
+func (tree *Tree) writableRoot() (*node, error) {
+	root, err := tree.load(tree.root)
+	if err != nil {
+		return nil, err
+	}
+	tree.writable(root)
+	tree.root = root.id
+	return root, nil
+}
+
+// writableChild loads the child of the writable node and prepares it for modification, updating the node's
+// reference to it if it was moved to a new page.
+func (tree *Tree) writableChild(node *node, index int) (*node, error) {
+	child, err := tree.load(node.children[index])
+	if err != nil {
+		return nil, err
+	}
+	tree.writable(child)
+	if node.children[index] != child.id {
+		node.children[index] = child.id
+		tree.store(node)
+	}
+	return child, nil
+}
+
+// insert inserts the entry into the subtree of the writable node and returns whether it was inserted.
+// The node may have one entry too many afterwards, so that its parent has to split it.
+func (tree *Tree) insert(node *node, entry *Entry) (inserted bool, err error) {
+	index, found := tree.search(node, entry.Key)
+	if found {
+		node.entries[index] = entry
+		tree.store(node)
+		return false, nil
+	}
+	if node.leaf() {
+		node.entries = append(node.entries, nil)
+		copy(node.entries[index+1:], node.entries[index:])
+		node.entries[index] = entry
+		tree.store(node)
+		return true, nil
+	}
+	child, err := tree.writableChild(node, index)
+	if err != nil {
+		return false, err
+	}
+	if inserted, err = tree.insert(child, entry); err != nil {
+		return false, err
+	}
+	if tree.shouldSplit(child) {
+		right, middle := tree.split(child)
+		node.entries = append(node.entries, nil)
+		copy(node.entries[index+1:], node.entries[index:])
+		node.entries[index] = middle
+		node.children = append(node.children, 0)
+		copy(node.children[index+2:], node.children[index+1:])
+		node.children[index+1] = right.id
+		tree.store(node)
+	}
+	return inserted, nil
+}
+
+// split moves the right half of the writable node to a new node and returns it with the middle entry.
+func (tree *Tree) split(left *node) (*node, *Entry) {
+	middle := len(left.entries) / 2
+	right := &node{id: tree.allocate(), entries: append([]*Entry(nil), left.entries[middle+1:]...)}
+	if !left.leaf() {
+		right.children = append([]uint64(nil), left.children[middle+1:]...)
+		left.children = left.children[:middle+1]
+	}
+	entry := left.entries[middle]
+	left.entries = left.entries[:middle]
+	tree.store(left)
+	tree.store(right)
+	return right, entry
+}
+
+// delete deletes the key, which exists in the subtree of the writable node.
+// The node may have one entry too few afterwards, so that its parent has to rebalance it.
+func (tree *Tree) delete(node *node, key interface{}) error {
+	index, found := tree.search(node, key)
+	if node.leaf() {
+		node.entries = append(node.entries[:index], node.entries[index+1:]...)
+		tree.store(node)
+		return nil
+	}
+	if found {
+		// Replace the entry by its predecessor, the maximum of the left subtree, and delete that one instead
+		predecessor, err := tree.max(node.children[index])
+		if err != nil {
+			return err
+		}
+		node.entries[index] = predecessor
+		tree.store(node)
+		key = predecessor.Key
+	}
+	child, err := tree.writableChild(node, index)
+	if err != nil {
+		return err
+	}
+	if err := tree.delete(child, key); err != nil {
+		return err
+	}
+	return tree.rebalance(node, index, child)
+}
+
+// max returns the maximum entry of the subtree.
+func (tree *Tree) max(id uint64) (*Entry, error) {
+	for {
+		node, err := tree.load(id)
+		if err != nil {
+			return nil, err
+		}
+		if node.leaf() {
+			return node.entries[len(node.entries)-1], nil
+		}
+		id = node.children[len(node.children)-1]
+	}
+}
+
+// rebalance restores the minimum number of entries of the writable child at the index of the writable node,
+// by borrowing an entry from a sibling or merging with a sibling.
+func (tree *Tree) rebalance(node *node, index int, child *node) error {
+	if len(child.entries) >= tree.minEntries() {
+		return nil
+	}
+
+	// check if borrowing from left sibling is possible
+	if index > 0 {
+		left, err := tree.load(node.children[index-1])
+		if err != nil {
+			return err
+		}
+		if len(left.entries) > tree.minEntries() {
+			if left, err = tree.writableChild(node, index-1); err != nil {
+				return err
+			}
+			child.entries = append([]*Entry{node.entries[index-1]}, child.entries...)
+			node.entries[index-1] = left.entries[len(left.entries)-1]
+			left.entries = left.entries[:len(left.entries)-1]
+			if !left.leaf() {
+				child.children = append([]uint64{left.children[len(left.children)-1]}, child.children...)
+				left.children = left.children[:len(left.children)-1]
+			}
+			tree.store(left)
+			tree.store(child)
+			tree.store(node)
+			return nil
+		}
+	}
+
+	// check if borrowing from right sibling is possible
+	if index < len(node.children)-1 {
+		right, err := tree.load(node.children[index+1])
+		if err != nil {
+			return err
+		}
+		if len(right.entries) > tree.minEntries() {
+			if right, err = tree.writableChild(node, index+1); err != nil {
+				return err
+			}
+			child.entries = append(child.entries, node.entries[index])
+			node.entries[index] = right.entries[0]
+			right.entries = append(right.entries[:0], right.entries[1:]...)
+			if !right.leaf() {
+				child.children = append(child.children, right.children[0])
+				right.children = append(right.children[:0], right.children[1:]...)
+			}
+			tree.store(right)
+			tree.store(child)
+			tree.store(node)
+			return nil
+		}
+	}
+
+	// merge with siblings
+	if index > 0 {
+		left, err := tree.writableChild(node, index-1)
+		if err != nil {
+			return err
+		}
+		tree.merge(node, index-1, left, child)
+	} else {
+		right, err := tree.load(node.children[index+1])
+		if err != nil {
+			return err
+		}
+		tree.merge(node, index, child, right)
+	}
+	return nil
+}
+
+// merge merges the right child at index+1 of the writable node and the separating entry into the writable
+// left child at the index and frees the right child's page.
+func (tree *Tree) merge(node *node, index int, left *node, right *node) {
+	left.entries = append(append(left.entries, node.entries[index]), right.entries...)
+	left.children = append(left.children, right.children...)
+	node.entries = append(node.entries[:index], node.entries[index+1:]...)
+	node.children = append(node.children[:index+1], node.children[index+2:]...)
+	tree.release(right)
+	tree.store(left)
+	tree.store(node)
+}
@@ -0,0 +1,980 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package diskbtree
+
+import (
+	"errors"
+	"fmt"
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/trees"
+	"github.com/uncle-gua/gods/trees/btree"
+	"github.com/uncle-gua/gods/utils"
+	"iter"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func openIntTree(t *testing.T, path string, order int, options *Options) *Tree {
+	t.Helper()
+	tree, err := Open(path, order, utils.IntComparator, IntCodec, StringCodec, options)
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	return tree
+}
+
+// closeTree closes the tree, e.g. deferred at the end of a test, and reports the error of its final commit.
+func closeTree(t *testing.T, tree *Tree) {
+	t.Helper()
+	if err := tree.Close(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+}
+
+// commitTree commits the tree and reports the error of the commit.
+func commitTree(t *testing.T, tree *Tree) {
+	t.Helper()
+	if err := tree.Commit(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+}
+
+func TestDiskBTreePutAndGet(t *testing.T) {
+	tree := openIntTree(t, filepath.Join(t.TempDir(), "tree"), 3, nil)
+	defer closeTree(t, tree)
+	tree.Put(5, "e")
+	tree.Put(6, "f")
+	tree.Put(7, "g")
+	tree.Put(3, "c")
+	tree.Put(4, "d")
+	tree.Put(1, "x")
+	tree.Put(2, "b")
+	tree.Put(1, "a") //overwrite
+	assertDiskBTree(t, tree)
+
+	if actualValue := tree.Size(); actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%d%d%d%d%d%d%d", tree.Keys()...), "1234567"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%s%s%s%s%s%s%s", tree.Values()...), "abcdefg"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tests := [][]interface{}{
+		{1, "a", true},
+		{2, "b", true},
+		{3, "c", true},
+		{4, "d", true},
+		{5, "e", true},
+		{6, "f", true},
+		{7, "g", true},
+		{8, nil, false},
+	}
+	for _, test := range tests {
+		actualValue, actualFound := tree.Get(test[0])
+		if actualValue != test[1] || actualFound != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+	if err := tree.Err(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+}
+
+func TestDiskBTreeRemove(t *testing.T) {
+	tree := openIntTree(t, filepath.Join(t.TempDir(), "tree"), 3, nil)
+	defer closeTree(t, tree)
+	tree.Remove(1)
+	for i := 1; i <= 7; i++ {
+		tree.Put(i, fmt.Sprintf("%d", i))
+	}
+
+	tree.Remove(5)
+	tree.Remove(6)
+	tree.Remove(7)
+	tree.Remove(8)
+	tree.Remove(5)
+	assertDiskBTree(t, tree)
+
+	if actualValue, expectedValue := fmt.Sprintf("%d%d%d%d", tree.Keys()...), "1234"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := tree.Size(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+
+	tree.Remove(1)
+	tree.Remove(4)
+	tree.Remove(2)
+	tree.Remove(3)
+	tree.Remove(2)
+	assertDiskBTree(t, tree)
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), "[]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if empty, size := tree.Empty(), tree.Size(); empty != true || size != 0 {
+		t.Errorf("Got %v expected %v", empty, true)
+	}
+}
+
+func TestDiskBTreeHeightAndLeftAndRight(t *testing.T) {
+	tree := openIntTree(t, filepath.Join(t.TempDir(), "tree"), 3, nil)
+	defer closeTree(t, tree)
+
+	if actualValue, expectedValue := tree.Height(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := tree.LeftKey(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := tree.RightValue(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	for i := 1; i <= 7; i++ {
+		tree.Put(i, fmt.Sprintf("%c", 'a'+i-1))
+	}
+	tests := [][]interface{}{
+		{tree.Height(), 3},
+		{tree.LeftKey(), 1},
+		{tree.LeftValue(), "a"},
+		{tree.RightKey(), 7},
+		{tree.RightValue(), "g"},
+	}
+	for _, test := range tests {
+		if actualValue, expectedValue := test[0], test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestDiskBTreeClear(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tree")
+	tree := openIntTree(t, path, 3, nil)
+	for i := 0; i < 20; i++ {
+		tree.Put(i, "a")
+	}
+	commitTree(t, tree)
+	for i := 20; i < 30; i++ {
+		tree.Put(i, "b")
+	}
+	tree.Clear()
+	assertDiskBTree(t, tree)
+	if actualValue, expectedValue := tree.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tree.Put(1, "c")
+	assertDiskBTree(t, tree)
+	if err := tree.Close(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	tree = openIntTree(t, path, 3, nil)
+	defer closeTree(t, tree)
+	assertDiskBTree(t, tree)
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), "[1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDiskBTreeRandom(t *testing.T) {
+	random := rand.New(rand.NewSource(3))
+	for order := 3; order <= 6; order++ {
+		path := filepath.Join(t.TempDir(), "tree")
+		options := &Options{PageSize: 512, CacheSize: 3}
+		tree := openIntTree(t, path, order, options)
+		expected := map[int]string{}
+		for i := 1; i <= 1500; i++ {
+			key := random.Intn(200)
+			if random.Intn(3) == 0 {
+				tree.Remove(key)
+				delete(expected, key)
+			} else {
+				value := fmt.Sprintf("%d", i)
+				tree.Put(key, value)
+				expected[key] = value
+			}
+			if i%50 == 0 {
+				assertDiskBTree(t, tree)
+			}
+			if i%100 == 0 {
+				if err := tree.Commit(); err != nil {
+					t.Fatalf("Got error %v", err)
+				}
+			}
+			if i%300 == 0 {
+				if err := tree.Close(); err != nil {
+					t.Fatalf("Got error %v", err)
+				}
+				tree = openIntTree(t, path, order, options)
+				assertDiskBTree(t, tree)
+				assertEntries(t, tree, expected)
+			}
+		}
+		assertEntries(t, tree, expected)
+		if err := tree.Close(); err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+}
+
+func TestDiskBTreeReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tree")
+	tree := openIntTree(t, path, 4, nil)
+	for i := 0; i < 100; i++ {
+		tree.Put(i, fmt.Sprintf("%d", i))
+	}
+	if err := tree.Commit(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	// uncommitted modifications are lost when the process ends without closing the tree
+	for i := 0; i < 50; i++ {
+		tree.Remove(i)
+	}
+	tree.Put(200, "x")
+	if err := tree.file.Close(); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+
+	tree = openIntTree(t, path, 4, nil)
+	assertDiskBTree(t, tree)
+	if actualValue, expectedValue := tree.Size(), 100; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := tree.Get(42); actualValue != "42" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "42")
+	}
+	if err := tree.Close(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := tree.Close(), ErrClosed; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, found := tree.Get(42); found {
+		t.Errorf("Should not find keys after close")
+	}
+
+	// order and page size of the file have to match
+	if _, err := Open(path, 5, utils.IntComparator, IntCodec, StringCodec, nil); err == nil {
+		t.Errorf("Should fail to open with another order")
+	}
+	if _, err := Open(path, 4, utils.IntComparator, IntCodec, StringCodec, &Options{PageSize: 1024}); err == nil {
+		t.Errorf("Should fail to open with another page size")
+	}
+	if _, err := Open(path, 2, utils.IntComparator, IntCodec, StringCodec, nil); err == nil {
+		t.Errorf("Should fail to open with invalid order")
+	}
+	if _, err := Open(path, 100, utils.IntComparator, IntCodec, StringCodec, &Options{PageSize: 512}); err == nil {
+		t.Errorf("Should fail to open with too small pages")
+	}
+}
+
+// errCrash is returned by the crashFile once it crashed.
+var errCrash = errors.New("crash")
+
+// crashFile simulates a crash after writing a number of bytes, i.e. it writes only a part of the write which
+// exceeds the budget and fails all writes and syncs afterwards.
+type crashFile struct {
+	*os.File
+	budget  int
+	written int
+}
+
+func (file *crashFile) WriteAt(data []byte, offset int64) (int, error) {
+	if file.written+len(data) > file.budget {
+		n, _ := file.File.WriteAt(data[:max(file.budget-file.written, 0)], offset)
+		file.written = file.budget
+		return n, errCrash
+	}
+	file.written += len(data)
+	return file.File.WriteAt(data, offset)
+}
+
+func (file *crashFile) Sync() error {
+	if file.written >= file.budget {
+		return errCrash
+	}
+	return file.File.Sync()
+}
+
+// crashCommit commits the base entries to a new tree at the path, then modifies them to the modified entries
+// (removing the even keys) with a budget of bytes to write and returns the error of the commit.
+func crashCommit(t *testing.T, path string, budget int, options *Options, base, modified map[int]string) error {
+	t.Helper()
+	tree := openIntTree(t, path, 4, options)
+	for key, value := range base {
+		tree.Put(key, value)
+	}
+	if err := tree.Close(); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	file, err := os.OpenFile(path, os.O_RDWR, 0644)
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}()
+	tree, err = open(&crashFile{File: file, budget: budget}, 4, utils.IntComparator, IntCodec, StringCodec, options)
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	for key := 0; key < 50; key += 2 {
+		tree.Remove(key)
+	}
+	for key := 50; key < 80; key++ {
+		tree.Put(key, modified[key])
+	}
+	return tree.Commit()
+}
+
+func TestDiskBTreeCrash(t *testing.T) {
+	options := &Options{PageSize: 256, CacheSize: 4}
+	base := map[int]string{}
+	modified := map[int]string{}
+	for i := 0; i < 50; i++ {
+		base[i] = fmt.Sprintf("%d", i)
+		if i%2 == 1 {
+			modified[i] = base[i]
+		}
+	}
+	for i := 50; i < 80; i++ {
+		modified[i] = fmt.Sprintf("%d", i)
+	}
+
+	dir := t.TempDir()
+	if err := crashCommit(t, filepath.Join(dir, "complete"), 1<<30, options, base, modified); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	complete := 0
+	for budget := 0; ; budget += 97 {
+		path := filepath.Join(dir, fmt.Sprintf("crash%d", budget))
+		err := crashCommit(t, path, budget, options, base, modified)
+		tree := openIntTree(t, path, 4, options)
+		assertDiskBTree(t, tree)
+		if err == nil {
+			// the commit completed within the budget
+			assertEntries(t, tree, modified)
+			closeTree(t, tree)
+			complete++
+			if complete == 3 {
+				break
+			}
+			continue
+		}
+		if err != errCrash {
+			t.Errorf("Got %v expected %v", err, errCrash)
+		}
+		// the tree recovers either the base or, if the meta page was written completely, the modified entries
+		if tree.Size() == len(base) {
+			assertEntries(t, tree, base)
+		} else {
+			assertEntries(t, tree, modified)
+		}
+		// the recovered tree can be modified and committed again
+		tree.Put(100, "x")
+		if err := tree.Close(); err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+}
+
+func TestDiskBTreeTruncated(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tree")
+	options := &Options{PageSize: 256}
+	tree := openIntTree(t, path, 3, options)
+	tree.Put(1, "a")
+	commitTree(t, tree)
+	info, _ := os.Stat(path)
+	committed := info.Size()
+	for i := 2; i <= 20; i++ {
+		tree.Put(i, "b")
+	}
+	closeTree(t, tree)
+
+	// a file truncated after the first commit falls back to it
+	if err := os.Truncate(path, committed+100); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	tree = openIntTree(t, path, 3, options)
+	assertDiskBTree(t, tree)
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), "[1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	closeTree(t, tree)
+
+	// without a valid meta page the file cannot be opened
+	if err := os.Truncate(path, 100); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if _, err := Open(path, 3, utils.IntComparator, IntCodec, StringCodec, options); !errors.Is(err, ErrCorrupted) {
+		t.Errorf("Got %v expected %v", err, ErrCorrupted)
+	}
+}
+
+func TestDiskBTreeCorruptedPage(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tree")
+	options := &Options{PageSize: 256}
+	tree := openIntTree(t, path, 3, options)
+	tree.Put(1, "a")
+	tree.Put(2, "b")
+	closeTree(t, tree)
+
+	file, err := os.OpenFile(path, os.O_RDWR, 0644)
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if _, err := file.WriteAt([]byte("garbage"), 2*256+10); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if err := file.Close(); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+
+	// the damaged root is detected while recovering the free pages
+	if _, err := Open(path, 3, utils.IntComparator, IntCodec, StringCodec, options); !errors.Is(err, ErrCorrupted) {
+		t.Errorf("Got %v expected %v", err, ErrCorrupted)
+	}
+}
+
+func TestDiskBTreeCodecs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tree")
+	tree, err := Open(path, 4, utils.StringComparator, StringCodec, JSONCodec, &Options{PageSize: 256})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	tree.Put("a", map[string]interface{}{"x": 1.5})
+	tree.Put("b", []interface{}{"y", true})
+	closeTree(t, tree)
+
+	tree, err = Open(path, 4, utils.StringComparator, StringCodec, JSONCodec, &Options{PageSize: 256})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Values()), "[map[x:1.5] [y true]]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tree.Put("c", strings.Repeat("z", tree.MaxEntrySize()))
+	if actualValue, expectedValue := tree.Err(), ErrEntryTooLarge; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, found := tree.Get("c"); found {
+		t.Errorf("Should not insert too large entry")
+	}
+	if actualValue, expectedValue := tree.Close(), ErrEntryTooLarge; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	for _, value := range []int{0, 1, -1, 1 << 40, -1 << 40} {
+		data, _ := IntCodec.Encode(value)
+		if actualValue, _ := IntCodec.Decode(data); actualValue != value {
+			t.Errorf("Got %v expected %v", actualValue, value)
+		}
+	}
+	if _, err := IntCodec.Decode([]byte{}); err == nil {
+		t.Errorf("Should fail to decode invalid int")
+	}
+}
+
+// keyValueTree is the key/value API shared by the in-memory and the file-backed B-trees.
+type keyValueTree interface {
+	trees.Tree
+	Put(key interface{}, value interface{})
+	Get(key interface{}) (value interface{}, found bool)
+	Remove(key interface{})
+	Keys() []interface{}
+	Height() int
+	LeftKey() interface{}
+	LeftValue() interface{}
+	RightKey() interface{}
+	RightValue() interface{}
+	AllSeq() iter.Seq2[interface{}, interface{}]
+	KeysSeq() iter.Seq[interface{}]
+	ValuesSeq() iter.Seq[interface{}]
+	Backward() iter.Seq2[interface{}, interface{}]
+}
+
+func TestDiskBTreeSameAsBTree(t *testing.T) {
+	tree := openIntTree(t, filepath.Join(t.TempDir(), "tree"), 3, &Options{CacheSize: 2})
+	defer closeTree(t, tree)
+	for _, tree := range []keyValueTree{btree.NewWithIntComparator(3), tree} {
+		for i := 20; i > 0; i-- {
+			tree.Put(i, fmt.Sprintf("%d", i))
+		}
+		for i := 1; i <= 20; i += 3 {
+			tree.Remove(i)
+		}
+		tests := [][]interface{}{
+			{fmt.Sprintf("%v", tree.Keys()), "[2 3 5 6 8 9 11 12 14 15 17 18 20]"},
+			{fmt.Sprintf("%v", slices.Collect(tree.ValuesSeq())), "[2 3 5 6 8 9 11 12 14 15 17 18 20]"},
+			{tree.Size(), 13},
+			{tree.Height(), 3},
+			{tree.LeftKey(), 2},
+			{tree.RightValue(), "20"},
+		}
+		for _, test := range tests {
+			if actualValue, expectedValue := test[0], test[1]; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+	}
+}
+
+func TestDiskBTreeIterator(t *testing.T) {
+	tree := openIntTree(t, filepath.Join(t.TempDir(), "tree"), 3, &Options{CacheSize: 2})
+	defer closeTree(t, tree)
+	it := tree.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty tree")
+	}
+	for it.Prev() {
+		t.Errorf("Shouldn't iterate on empty tree")
+	}
+
+	for i := 20; i > 0; i-- {
+		tree.Put(i, fmt.Sprintf("%d", i*10))
+	}
+	it = tree.Iterator()
+	count := 0
+	for it.Next() {
+		count++
+		if actualValue, expectedValue := it.Key(), count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := it.Value(), fmt.Sprintf("%d", count*10); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := count, tree.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for it.Prev() {
+		if actualValue, expectedValue := it.Key(), count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		count--
+	}
+	if actualValue, expectedValue := count, 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDiskBTreeIteratorFirstLastTo(t *testing.T) {
+	tree := openIntTree(t, filepath.Join(t.TempDir(), "tree"), 3, &Options{CacheSize: 2})
+	defer closeTree(t, tree)
+	for i := 20; i > 0; i-- {
+		tree.Put(i, fmt.Sprintf("%d", i*10))
+	}
+	it := tree.Iterator()
+	if !it.First() || it.Key() != 1 {
+		t.Errorf("Got %v expected %v", it.Key(), 1)
+	}
+	if !it.Last() || it.Key() != 20 {
+		t.Errorf("Got %v expected %v", it.Key(), 20)
+	}
+	it.Begin()
+	if !it.NextTo(func(key interface{}, value interface{}) bool { return key.(int)%7 == 0 }) || it.Key() != 7 {
+		t.Errorf("Got %v expected %v", it.Key(), 7)
+	}
+	clone := it.Clone()
+	if !it.PrevTo(func(key interface{}, value interface{}) bool { return key.(int)%5 == 0 }) || it.Key() != 5 {
+		t.Errorf("Got %v expected %v", it.Key(), 5)
+	}
+	if !clone.Next() || clone.Key() != 8 {
+		t.Errorf("Got %v expected %v", clone.Key(), 8)
+	}
+}
+
+func TestDiskBTreeIteratorSeek(t *testing.T) {
+	tree := openIntTree(t, filepath.Join(t.TempDir(), "tree"), 3, nil)
+	defer closeTree(t, tree)
+	for i := 2; i <= 20; i += 2 {
+		tree.Put(i, "")
+	}
+	it := tree.Iterator()
+	tests := [][]interface{}{
+		{it.SeekGE, 4, true, 4},
+		{it.SeekGE, 5, true, 6},
+		{it.SeekGE, 0, true, 2},
+		{it.SeekGE, 21, false, nil},
+		{it.SeekGT, 4, true, 6},
+		{it.SeekGT, 20, false, nil},
+		{it.SeekLE, 4, true, 4},
+		{it.SeekLE, 5, true, 4},
+		{it.SeekLE, 1, false, nil},
+		{it.SeekLT, 4, true, 2},
+		{it.SeekLT, 100, true, 20},
+		{it.SeekLT, 2, false, nil},
+	}
+	for _, test := range tests {
+		found := test[0].(func(interface{}) bool)(test[1])
+		if actualValue, expectedValue := found, test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if found && it.Key() != test[3] {
+			t.Errorf("Got %v expected %v", it.Key(), test[3])
+		}
+	}
+
+	// iteration continues from the sought element in both directions
+	it.SeekGE(5)
+	if !it.Next() || it.Key() != 8 {
+		t.Errorf("Got %v expected %v", it.Key(), 8)
+	}
+	it.SeekGE(5)
+	if !it.Prev() || it.Key() != 4 {
+		t.Errorf("Got %v expected %v", it.Key(), 4)
+	}
+	it.SeekGT(20)
+	if !it.Prev() || it.Key() != 20 {
+		t.Errorf("Got %v expected %v", it.Key(), 20)
+	}
+	it.SeekLT(2)
+	if !it.Next() || it.Key() != 2 {
+		t.Errorf("Got %v expected %v", it.Key(), 2)
+	}
+}
+
+func TestDiskBTreeIteratorSeekEveryKey(t *testing.T) {
+	tree := openIntTree(t, filepath.Join(t.TempDir(), "tree"), 3, nil)
+	defer closeTree(t, tree)
+	for i := 2; i <= 20; i += 2 {
+		tree.Put(i, "")
+	}
+	it := tree.Iterator()
+	// every key from internal nodes and leaves
+	for key := 1; key <= 21; key++ {
+		ceiling, floor := (key+1)/2*2, key/2*2
+		if found := it.SeekGE(key); found != (ceiling <= 20) || found && it.Key() != ceiling {
+			t.Errorf("Got %v expected %v", it.Key(), ceiling)
+		}
+		if found := it.SeekLE(key); found != (floor >= 2) || found && it.Key() != min(floor, 20) {
+			t.Errorf("Got %v expected %v", it.Key(), floor)
+		}
+	}
+}
+
+func TestDiskBTreeRangeIterator(t *testing.T) {
+	tree := openIntTree(t, filepath.Join(t.TempDir(), "tree"), 3, nil)
+	defer closeTree(t, tree)
+	for i := 2; i <= 20; i += 2 {
+		tree.Put(i, "")
+	}
+	it := tree.RangeIterator(5, 15)
+	keys := []interface{}{}
+	for it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[6 8 10 12 14]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	keys = []interface{}{}
+	for it.Prev() {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[14 12 10 8 6]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tests := [][]interface{}{
+		{it.First, 6},
+		{it.Last, 14},
+		{func() bool { return it.SeekGE(1) }, 6},
+		{func() bool { return it.SeekGT(10) }, 12},
+		{func() bool { return it.SeekLE(100) }, 14},
+		{func() bool { return it.SeekLT(6) }, nil},
+		{func() bool { return it.SeekGE(15) }, nil},
+	}
+	for _, test := range tests {
+		found := test[0].(func() bool)()
+		if actualValue, expectedValue := found, test[1] != nil; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if found && it.Key() != test[1] {
+			t.Errorf("Got %v expected %v", it.Key(), test[1])
+		}
+	}
+}
+
+func TestDiskBTreeIteratorConcurrentModification(t *testing.T) {
+	tree := openIntTree(t, filepath.Join(t.TempDir(), "tree"), 3, nil)
+	defer closeTree(t, tree)
+	tree.Put(1, "a")
+	tree.Put(2, "b")
+	tree.Put(3, "c")
+
+	// modifications before the first element are allowed
+	it := tree.Iterator()
+	tree.Put(4, "d")
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, tree.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// modifications after Begin() or End() are allowed, moving in either direction accepts them
+	it.End()
+	tree.Put(6, "f")
+	if it.Next() {
+		t.Errorf("Should not iterate past the last element")
+	}
+	if !it.Prev() || it.Key() != 6 {
+		t.Errorf("Got %v expected %v", it.Key(), 6)
+	}
+	it.Begin()
+	tree.Remove(6)
+	if it.Prev() {
+		t.Errorf("Should not iterate before the first element")
+	}
+	if !it.Next() || it.Key() != 1 {
+		t.Errorf("Got %v expected %v", it.Key(), 1)
+	}
+
+	// updating a value during iteration panics, since it moves the nodes
+	func() {
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		it := tree.Iterator()
+		it.Next()
+		tree.Put(1, "x")
+		it.Next()
+	}()
+
+	// checked iterator stops and reports the error
+	checked := tree.CheckedIterator()
+	checked.Next()
+	tree.Remove(2)
+	if checked.Next() {
+		t.Errorf("Should not iterate after modification")
+	}
+	if actualValue, expectedValue := checked.Err(), containers.ErrConcurrentModification; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDiskBTreeIteratorModifiedAtBoundaries(t *testing.T) {
+	tree := openIntTree(t, filepath.Join(t.TempDir(), "tree"), 3, nil)
+	defer closeTree(t, tree)
+	tree.Put(2, "b")
+	tree.Put(3, "c")
+	it := tree.Iterator()
+	it.Next()
+
+	// moving from Begin() or End() after a modification starts over on the modified container
+	tests := [][]interface{}{
+		{true, true, 1, 1},
+		{false, true, 4, 4},
+		{true, false, 1, 2},
+		{false, false, 4, 3},
+	}
+	for _, test := range tests {
+		forward, put, key, expectedValue := test[0].(bool), test[1].(bool), test[2], test[3]
+		if forward {
+			it.Begin()
+		} else {
+			it.End()
+		}
+		if put {
+			tree.Put(key, "x")
+		} else {
+			tree.Remove(key)
+		}
+		moved := false
+		if forward {
+			moved = it.Next()
+		} else {
+			moved = it.Prev()
+		}
+		if !moved {
+			t.Errorf("Got %v expected %v", moved, true)
+			continue
+		}
+		if actualValue := it.Key(); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestDiskBTreeSeq(t *testing.T) {
+	tree := openIntTree(t, filepath.Join(t.TempDir(), "tree"), 3, nil)
+	defer closeTree(t, tree)
+	tree.Put(3, "c")
+	tree.Put(1, "a")
+	tree.Put(2, "b")
+
+	pairs := []string{}
+	for key, value := range tree.AllSeq() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "1:a 2:b 3:c"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(tree.KeysSeq())), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	pairs = []string{}
+	for key, value := range tree.Backward() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "3:c 2:b 1:a"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDiskBTreeString(t *testing.T) {
+	tree := openIntTree(t, filepath.Join(t.TempDir(), "tree"), 3, nil)
+	defer closeTree(t, tree)
+	for i := 1; i <= 3; i++ {
+		tree.Put(i, "")
+	}
+	if actualValue, expectedValue := tree.String(), "DiskBTree\n    1\n2\n    3\n"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+// assertEntries checks that the tree holds exactly the expected entries.
+func assertEntries(t *testing.T, tree *Tree, expected map[int]string) {
+	t.Helper()
+	keys := make([]int, 0, len(expected))
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	values := make([]string, len(keys))
+	for i, key := range keys {
+		values[i] = expected[key]
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), fmt.Sprintf("%v", keys); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Values()), fmt.Sprintf("%v", values); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := tree.Size(), len(keys); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+// assertDiskBTree checks the B-tree properties of the nodes, that the cache is within its size and that every page
+// is used exactly once, i.e. by a node, as a free page or as a page to be freed after the next commit.
+// diskBTreeChecker walks the nodes of a tree and records the usage of its pages, see assertDiskBTree.
+type diskBTreeChecker struct {
+	t         *testing.T
+	tree      *Tree
+	used      map[uint64]string
+	leafDepth int
+}
+
+func assertDiskBTree(t *testing.T, tree *Tree) {
+	t.Helper()
+	if err := tree.Err(); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if len(tree.cache) > tree.cacheSize {
+		t.Errorf("Got %v cached nodes expected at most %v", len(tree.cache), tree.cacheSize)
+	}
+	checker := &diskBTreeChecker{t: t, tree: tree, used: make(map[uint64]string), leafDepth: -1}
+	size := 0
+	if tree.root != 0 {
+		size = checker.check(tree.root, 0, nil, nil)
+	}
+	tree.evict()
+	if size != tree.Size() {
+		t.Errorf("Got %v expected %v", size, tree.Size())
+	}
+	for id := range tree.fresh {
+		if checker.used[id] != "node" {
+			t.Errorf("Got fresh page %v used as %v", id, checker.used[id])
+		}
+	}
+	for _, id := range tree.free {
+		checker.use(id, "free")
+	}
+	for _, id := range tree.pending {
+		checker.use(id, "pending")
+	}
+	if actualValue, expectedValue := len(checker.used), int(tree.pageCount)-metaPages; actualValue != expectedValue {
+		t.Errorf("Got %v used pages expected %v", actualValue, expectedValue)
+	}
+}
+
+// use records the usage of the page and reports pages out of range or used twice.
+func (checker *diskBTreeChecker) use(id uint64, usage string) {
+	checker.t.Helper()
+	if id < metaPages || id >= checker.tree.pageCount || checker.used[id] != "" {
+		checker.t.Errorf("Got page %v used as %v and %v", id, checker.used[id], usage)
+	}
+	checker.used[id] = usage
+}
+
+// check checks the subtree of the node stored in the page, whose keys are between lower and upper (nil if unbounded),
+// and returns its size.
+func (checker *diskBTreeChecker) check(id uint64, depth int, lower, upper interface{}) int {
+	t, tree := checker.t, checker.tree
+	t.Helper()
+	checker.use(id, "node")
+	node, err := tree.load(id)
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	checker.checkEntries(node, depth, lower, upper)
+	size := len(node.entries)
+	if node.leaf() {
+		if checker.leafDepth == -1 {
+			checker.leafDepth = depth
+		}
+		if depth != checker.leafDepth {
+			t.Errorf("Got leaf depth %v expected %v", depth, checker.leafDepth)
+		}
+		return size
+	}
+	if len(node.children) != len(node.entries)+1 {
+		t.Errorf("Got %v children expected %v", len(node.children), len(node.entries)+1)
+	}
+	children := node.children
+	entries := node.entries
+	for i, child := range children {
+		childLower, childUpper := lower, upper
+		if i > 0 {
+			childLower = entries[i-1].Key
+		}
+		if i < len(entries) {
+			childUpper = entries[i].Key
+		}
+		size += checker.check(child, depth+1, childLower, childUpper)
+	}
+	return size
+}
+
+// checkEntries checks the number of entries of the node and that their keys are in order between lower and upper.
+func (checker *diskBTreeChecker) checkEntries(node *node, depth int, lower, upper interface{}) {
+	t, tree := checker.t, checker.tree
+	t.Helper()
+	if len(node.entries) > tree.maxEntries() || depth > 0 && len(node.entries) < tree.minEntries() || len(node.entries) == 0 {
+		t.Errorf("Got %v entries in node %v", len(node.entries), node.entries)
+	}
+	for i, entry := range node.entries {
+		if lower != nil && tree.Comparator(entry.Key, lower) <= 0 || upper != nil && tree.Comparator(entry.Key, upper) >= 0 ||
+			i > 0 && tree.Comparator(node.entries[i-1].Key, entry.Key) >= 0 {
+			t.Errorf("Got key %v out of order in node %v", entry.Key, node.entries)
+		}
+	}
+}
@@ -0,0 +1,475 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package diskbtree
+
+import (
+	"iter"
+
+	"github.com/uncle-gua/gods/containers"
+)
+
+// Assert Iterator implementation
+var _ containers.ReverseIteratorWithKey = (*Iterator)(nil)
+
+// Iterator holding the iterator's state
+type Iterator struct {
+	tree     *Tree
+	path     []frame // nodes from the root to the current entry, since nodes are loaded from pages without parent links
+	position position
+	bounded  bool
+	from, to interface{}
+	failFast containers.FailFast
+}
+
+// frame is a node on the path of the iterator. The index is the one of the current entry if the node is the last one
+// on the path, otherwise the one of the child the path continues in, whose neighbouring entries are next in order.
+type frame struct {
+	node  *node
+	index int
+}
+
+type position byte
+
+const (
+	begin, between, end position = 0, 1, 2
+)
+
+// Iterator returns a stateful iterator whose elements are key/value pairs.
+// Nodes are loaded from the file when the iterator reaches them. If loading fails, the iterator stops and the error
+// is reported by the tree's Err().
+// The iterator fails fast: moving it after the tree was modified other than through the iterator panics with
+// containers.ErrConcurrentModification. Since modified nodes are moved to new pages, updating a value is a
+// modification too. Modifications made while the iterator is before the first or past the last element,
+// e.g. after Begin() or End(), are allowed.
+func (tree *Tree) Iterator() Iterator {
+	return Iterator{tree: tree, position: begin, failFast: containers.NewFailFast(tree.modCount)}
+}
+
+// CheckedIterator returns a stateful iterator like Iterator(), except that instead of panicking when the tree was
+// modified during iteration, it stops and reports containers.ErrConcurrentModification by Err().
+func (tree *Tree) CheckedIterator() Iterator {
+	iterator := tree.Iterator()
+	iterator.failFast.Check()
+	return iterator
+}
+
+// RangeIterator returns a stateful iterator like Iterator(), restricted to the elements whose keys are in the
+// half-open range [from, to), i.e. it moves from its initial state to the first element not smaller than from,
+// and past its last element when the next element would not be smaller than to (and vice versa in reverse).
+// Keys should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) RangeIterator(from, to interface{}) Iterator {
+	iterator := tree.Iterator()
+	iterator.bounded, iterator.from, iterator.to = true, from, to
+	return iterator
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator) Next() bool {
+	if !iterator.failFast.Move(iterator.tree.modCount, iterator.position != between) {
+		return false
+	}
+	defer iterator.tree.evict()
+	var found bool
+	switch iterator.position {
+	case end:
+		return false
+	case begin:
+		found = iterator.first()
+	default:
+		found = iterator.next()
+	}
+	if !found || !iterator.withinBounds(iterator.Key()) {
+		iterator.End()
+		return false
+	}
+	iterator.position = between
+	return true
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) Prev() bool {
+	if !iterator.failFast.Move(iterator.tree.modCount, iterator.position != between) {
+		return false
+	}
+	defer iterator.tree.evict()
+	var found bool
+	switch iterator.position {
+	case begin:
+		return false
+	case end:
+		found = iterator.last()
+	default:
+		found = iterator.prev()
+	}
+	if !found || !iterator.withinBounds(iterator.Key()) {
+		iterator.Begin()
+		return false
+	}
+	iterator.position = between
+	return true
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Value() interface{} {
+	return iterator.entry().Value
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Key() interface{} {
+	return iterator.entry().Key
+}
+
+// Clone returns an independent copy of the iterator at the same position, e.g. for lookahead or backtracking.
+// Moving either of the iterators does not move the other one.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Clone() Iterator {
+	clone := *iterator
+	clone.path = append([]frame(nil), iterator.path...)
+	return clone
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator) Begin() {
+	iterator.path = iterator.path[:0]
+	iterator.position = begin
+	iterator.sync()
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *Iterator) End() {
+	iterator.path = iterator.path[:0]
+	iterator.position = end
+	iterator.sync()
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator
+func (iterator *Iterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the container.
+// If Last() returns true, then last element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If NextTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) NextTo(f func(key interface{}, value interface{}) bool) bool {
+	for iterator.Next() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// PrevTo moves the iterator to the previous element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If PrevTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) PrevTo(f func(key interface{}, value interface{}) bool) bool {
+	for iterator.Prev() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// SeekGE moves the iterator to the first element whose key is greater than or equal to the given key and returns
+// true if there was such an element, otherwise moves the iterator past the last element.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekGE(key interface{}) bool {
+	return iterator.seekForward(key, true)
+}
+
+// SeekGT moves the iterator to the first element whose key is greater than the given key and returns
+// true if there was such an element, otherwise moves the iterator past the last element.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekGT(key interface{}) bool {
+	return iterator.seekForward(key, false)
+}
+
+// SeekLE moves the iterator to the last element whose key is smaller than or equal to the given key and returns
+// true if there was such an element, otherwise moves the iterator before the first element.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekLE(key interface{}) bool {
+	return iterator.seekBackward(key, true)
+}
+
+// SeekLT moves the iterator to the last element whose key is smaller than the given key and returns
+// true if there was such an element, otherwise moves the iterator before the first element.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekLT(key interface{}) bool {
+	return iterator.seekBackward(key, false)
+}
+
+// seekForward moves the iterator to the ceiling of the key, or to the first element if the ceiling is below the
+// range of the iterator.
+func (iterator *Iterator) seekForward(key interface{}, inclusive bool) bool {
+	defer iterator.tree.evict()
+	iterator.sync()
+	found := iterator.ceiling(key, inclusive)
+	if found && iterator.bounded && iterator.tree.Comparator(iterator.Key(), iterator.from) < 0 {
+		found = iterator.first()
+	}
+	if !found || !iterator.withinBounds(iterator.Key()) {
+		iterator.End()
+		return false
+	}
+	iterator.position = between
+	return true
+}
+
+// seekBackward moves the iterator to the floor of the key, or to the last element if the floor is above the
+// range of the iterator.
+func (iterator *Iterator) seekBackward(key interface{}, inclusive bool) bool {
+	defer iterator.tree.evict()
+	iterator.sync()
+	found := iterator.floor(key, inclusive)
+	if found && iterator.bounded && iterator.tree.Comparator(iterator.Key(), iterator.to) >= 0 {
+		found = iterator.last()
+	}
+	if !found || !iterator.withinBounds(iterator.Key()) {
+		iterator.Begin()
+		return false
+	}
+	iterator.position = between
+	return true
+}
+
+// first moves the path to the first entry within the range of the iterator and returns true if there is one.
+func (iterator *Iterator) first() bool {
+	if iterator.bounded {
+		return iterator.ceiling(iterator.from, true)
+	}
+	iterator.path = iterator.path[:0]
+	return iterator.descend(iterator.tree.root, false)
+}
+
+// last moves the path to the last entry within the range of the iterator and returns true if there is one.
+func (iterator *Iterator) last() bool {
+	if iterator.bounded {
+		return iterator.floor(iterator.to, false)
+	}
+	iterator.path = iterator.path[:0]
+	return iterator.descend(iterator.tree.root, true)
+}
+
+// next moves the path to the in-order successor of the current entry and returns true if there is one.
+func (iterator *Iterator) next() bool {
+	top := &iterator.path[len(iterator.path)-1]
+	if !top.node.leaf() {
+		top.index++
+		return iterator.descend(top.node.children[top.index], false)
+	}
+	if top.index+1 < len(top.node.entries) {
+		top.index++
+		return true
+	}
+	return iterator.ascend(false)
+}
+
+// prev moves the path to the in-order predecessor of the current entry and returns true if there is one.
+func (iterator *Iterator) prev() bool {
+	top := &iterator.path[len(iterator.path)-1]
+	if !top.node.leaf() {
+		return iterator.descend(top.node.children[top.index], true)
+	}
+	if top.index > 0 {
+		top.index--
+		return true
+	}
+	return iterator.ascend(true)
+}
+
+// descend appends the node and its left-most (or, if reverse, right-most) descendants to the path,
+// ending at the first (or last) entry of the subtree.
+func (iterator *Iterator) descend(id uint64, reverse bool) bool {
+	for id != 0 {
+		node, err := iterator.tree.load(id)
+		if err != nil {
+			iterator.tree.fail(err)
+			return false
+		}
+		index := 0
+		if reverse {
+			index = len(node.children) - 1
+			if node.leaf() {
+				index = len(node.entries) - 1
+			}
+		}
+		iterator.path = append(iterator.path, frame{node, index})
+		if node.leaf() {
+			return true
+		}
+		id = node.children[index]
+	}
+	return false
+}
+
+// ascend removes exhausted nodes from the path up to the first ancestor with an entry after (or, if reverse, before)
+// the child the path continues in, which is the successor (or predecessor) of a subtree's last (or first) entry.
+func (iterator *Iterator) ascend(reverse bool) bool {
+	iterator.path = iterator.path[:len(iterator.path)-1]
+	for len(iterator.path) > 0 {
+		top := &iterator.path[len(iterator.path)-1]
+		if !reverse && top.index < len(top.node.entries) {
+			return true
+		}
+		if reverse && top.index > 0 {
+			top.index--
+			return true
+		}
+		iterator.path = iterator.path[:len(iterator.path)-1]
+	}
+	return false
+}
+
+// ceiling moves the path to the smallest entry whose key is greater than (or, if inclusive, equal to) the given key
+// and returns true if there is one.
+func (iterator *Iterator) ceiling(key interface{}, inclusive bool) bool {
+	iterator.path = iterator.path[:0]
+	for id := iterator.tree.root; id != 0; {
+		node, err := iterator.tree.load(id)
+		if err != nil {
+			iterator.tree.fail(err)
+			return false
+		}
+		index, found := iterator.tree.search(node, key)
+		iterator.path = append(iterator.path, frame{node, index})
+		if found {
+			return inclusive || iterator.next()
+		}
+		if node.leaf() {
+			if index < len(node.entries) {
+				return true
+			}
+			return iterator.ascend(false)
+		}
+		id = node.children[index]
+	}
+	return false
+}
+
+// floor moves the path to the largest entry whose key is smaller than (or, if inclusive, equal to) the given key
+// and returns true if there is one.
+func (iterator *Iterator) floor(key interface{}, inclusive bool) bool {
+	iterator.path = iterator.path[:0]
+	for id := iterator.tree.root; id != 0; {
+		node, err := iterator.tree.load(id)
+		if err != nil {
+			iterator.tree.fail(err)
+			return false
+		}
+		index, found := iterator.tree.search(node, key)
+		iterator.path = append(iterator.path, frame{node, index})
+		if found {
+			return inclusive || iterator.prev()
+		}
+		if node.leaf() {
+			if index > 0 {
+				iterator.path[len(iterator.path)-1].index--
+				return true
+			}
+			return iterator.ascend(true)
+		}
+		id = node.children[index]
+	}
+	return false
+}
+
+// entry returns the current entry.
+func (iterator *Iterator) entry() *Entry {
+	top := iterator.path[len(iterator.path)-1]
+	return top.node.entries[top.index]
+}
+
+// withinBounds returns true if the key is within the range of the iterator.
+func (iterator *Iterator) withinBounds(key interface{}) bool {
+	return !iterator.bounded ||
+		iterator.tree.Comparator(key, iterator.from) >= 0 && iterator.tree.Comparator(key, iterator.to) < 0
+}
+
+// Err returns containers.ErrConcurrentModification if the checked iterator stopped because the tree was modified
+// during iteration, otherwise nil.
+func (iterator *Iterator) Err() error {
+	return iterator.failFast.Err()
+}
+
+// sync accepts the current state of the tree, i.e. after the iterator was reset.
+func (iterator *Iterator) sync() {
+	iterator.failFast.Sync(iterator.tree.modCount)
+}
+
+// AllSeq returns an iterator over key-value pairs of the tree in order, for use with range-over-func.
+func (tree *Tree) AllSeq() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		iterator := tree.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Key(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns an iterator over keys of the tree in order, for use with range-over-func.
+// It is not named Keys, which returns the keys as a slice.
+func (tree *Tree) KeysSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		iterator := tree.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Key()) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over values of the tree in order of their keys, for use with range-over-func.
+// It is not named Values, which returns the values as a slice.
+func (tree *Tree) ValuesSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		iterator := tree.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over key-value pairs of the tree in reverse order, for use with range-over-func.
+func (tree *Tree) Backward() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		iterator := tree.Iterator()
+		iterator.End()
+		for iterator.Prev() {
+			if !yield(iterator.Key(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
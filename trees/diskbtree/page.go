@@ -0,0 +1,462 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package diskbtree
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+)
+
+// ErrCorrupted is returned when a page of the file does not hold what it should, e.g. after it was damaged.
+var ErrCorrupted = errors.New("diskbtree: file is corrupted")
+
+// ErrEntryTooLarge is reported when an encoded key and value do not fit into a page together with the other entries of a node.
+var ErrEntryTooLarge = errors.New("diskbtree: entry is too large for the page size")
+
+// ErrClosed is reported when the tree is used after it was closed.
+var ErrClosed = errors.New("diskbtree: tree is closed")
+
+// file is the storage of the pages, satisfied by *os.File.
+type file interface {
+	io.ReaderAt
+	io.WriterAt
+	Sync() error
+	Truncate(size int64) error
+	Stat() (os.FileInfo, error)
+	Close() error
+}
+
+const (
+	magic      = "GODSBTRE"
+	version    = 1
+	metaSize   = 8 + 4 + 4 + 4 + 8 + 8 + 8 + 8 + 4 // magic, version, page size, order, txid, root, size, page count, checksum
+	metaPages  = 2                                 // pages 0 and 1 hold the meta alternately, so 0 is not a valid node page
+	nodeHeader = 4 + 4                             // checksum and length of the payload
+	leafFlag   = 1
+)
+
+// meta is the committed state of the tree, stored alternately in page 0 and page 1.
+type meta struct {
+	pageSize  int
+	order     int
+	txid      uint64 // number of the commit, the meta with the highest valid number is the current one
+	root      uint64 // page of the root node or 0 if the tree is empty
+	size      int
+	pageCount uint64 // number of pages in use, including the free ones
+}
+
+func (m *meta) encode(pageSize int) []byte {
+	page := make([]byte, pageSize)
+	copy(page, magic)
+	binary.LittleEndian.PutUint32(page[8:], version)
+	binary.LittleEndian.PutUint32(page[12:], uint32(m.pageSize))
+	binary.LittleEndian.PutUint32(page[16:], uint32(m.order))
+	binary.LittleEndian.PutUint64(page[20:], m.txid)
+	binary.LittleEndian.PutUint64(page[28:], m.root)
+	binary.LittleEndian.PutUint64(page[36:], uint64(m.size))
+	binary.LittleEndian.PutUint64(page[44:], m.pageCount)
+	binary.LittleEndian.PutUint32(page[52:], crc32.ChecksumIEEE(page[:52]))
+	return page
+}
+
+func (m *meta) decode(page []byte) bool {
+	if len(page) < metaSize || string(page[:8]) != magic || binary.LittleEndian.Uint32(page[8:]) != version ||
+		binary.LittleEndian.Uint32(page[52:]) != crc32.ChecksumIEEE(page[:52]) {
+		return false
+	}
+	m.pageSize = int(binary.LittleEndian.Uint32(page[12:]))
+	m.order = int(binary.LittleEndian.Uint32(page[16:]))
+	m.txid = binary.LittleEndian.Uint64(page[20:])
+	m.root = binary.LittleEndian.Uint64(page[28:])
+	m.size = int(binary.LittleEndian.Uint64(page[36:]))
+	m.pageCount = binary.LittleEndian.Uint64(page[44:])
+	return true
+}
+
+// node is a decoded page holding a node of the tree.
+type node struct {
+	id       uint64   // page of the node
+	entries  []*Entry // entries of the node
+	children []uint64 // pages of the children, empty for leaves
+	dirty    bool     // true if the node was modified since it was written
+	newer    *node    // neighbour in the cache towards the most recently used node
+	older    *node    // neighbour in the cache towards the least recently used node
+}
+
+func (node *node) leaf() bool {
+	return len(node.children) == 0
+}
+
+// encode writes the node into a page as a checksum, the length of the payload and the payload, which consists of
+// a flag, the number of entries, the pages of the children and the lengths and bytes of the keys and values.
+func (tree *Tree) encode(node *node) ([]byte, error) {
+	payload := make([]byte, nodeHeader, tree.pageSize)
+	if node.leaf() {
+		payload = append(payload, leafFlag)
+	} else {
+		payload = append(payload, 0)
+	}
+	payload = binary.AppendUvarint(payload, uint64(len(node.entries)))
+	for _, child := range node.children {
+		payload = binary.AppendUvarint(payload, child)
+	}
+	for _, entry := range node.entries {
+		key, err := tree.KeyCodec.Encode(entry.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := tree.ValueCodec.Encode(entry.Value)
+		if err != nil {
+			return nil, err
+		}
+		payload = binary.AppendUvarint(payload, uint64(len(key)))
+		payload = append(payload, key...)
+		payload = binary.AppendUvarint(payload, uint64(len(value)))
+		payload = append(payload, value...)
+	}
+	if len(payload) > tree.pageSize {
+		return nil, ErrEntryTooLarge
+	}
+	binary.LittleEndian.PutUint32(payload[4:], uint32(len(payload)-nodeHeader))
+	binary.LittleEndian.PutUint32(payload[0:], crc32.ChecksumIEEE(payload[4:]))
+	return payload[:tree.pageSize], nil
+}
+
+// decode reads the node from the page, verifying its checksum.
+func (tree *Tree) decode(id uint64, page []byte) (*node, error) {
+	length := int(binary.LittleEndian.Uint32(page[4:]))
+	if nodeHeader+length > len(page) || binary.LittleEndian.Uint32(page) != crc32.ChecksumIEEE(page[4:nodeHeader+length]) {
+		return nil, fmt.Errorf("%w: invalid page %d", ErrCorrupted, id)
+	}
+	payload := page[nodeHeader : nodeHeader+length]
+	corrupted := fmt.Errorf("%w: invalid node in page %d", ErrCorrupted, id)
+	uvarint := func() uint64 {
+		value, n := binary.Uvarint(payload)
+		if n <= 0 {
+			payload = nil
+			return 0
+		}
+		payload = payload[n:]
+		return value
+	}
+	bytes := func() []byte {
+		length := uvarint()
+		if length > uint64(len(payload)) {
+			payload = nil
+			return nil
+		}
+		data := payload[:length]
+		payload = payload[length:]
+		return data
+	}
+	if len(payload) == 0 {
+		return nil, corrupted
+	}
+	leaf := payload[0] == leafFlag
+	payload = payload[1:]
+	count := uvarint()
+	if payload == nil || count > uint64(tree.m) {
+		return nil, corrupted
+	}
+	node := &node{id: id, entries: make([]*Entry, count)}
+	if !leaf {
+		node.children = make([]uint64, count+1)
+		for i := range node.children {
+			node.children[i] = uvarint()
+		}
+	}
+	for i := range node.entries {
+		key, value := bytes(), bytes()
+		if payload == nil {
+			return nil, corrupted
+		}
+		entry := &Entry{}
+		var err error
+		if entry.Key, err = tree.KeyCodec.Decode(key); err != nil {
+			return nil, err
+		}
+		if entry.Value, err = tree.ValueCodec.Decode(value); err != nil {
+			return nil, err
+		}
+		node.entries[i] = entry
+	}
+	return node, nil
+}
+
+// load returns the node stored in the page, from the cache if possible.
+func (tree *Tree) load(id uint64) (*node, error) {
+	if node, found := tree.cache[id]; found {
+		tree.touch(node)
+		return node, nil
+	}
+	if id < metaPages || id >= tree.pageCount {
+		return nil, fmt.Errorf("%w: invalid page %d", ErrCorrupted, id)
+	}
+	page := make([]byte, tree.pageSize)
+	if _, err := tree.file.ReadAt(page, int64(id)*int64(tree.pageSize)); err != nil {
+		return nil, err
+	}
+	node, err := tree.decode(id, page)
+	if err != nil {
+		return nil, err
+	}
+	tree.cache[id] = node
+	tree.touch(node)
+	return node, nil
+}
+
+// store marks the node as modified and keeps it in the cache until it is written.
+func (tree *Tree) store(node *node) {
+	node.dirty = true
+	tree.cache[node.id] = node
+	tree.touch(node)
+}
+
+// write writes the node to its page.
+func (tree *Tree) write(node *node) error {
+	page, err := tree.encode(node)
+	if err != nil {
+		return err
+	}
+	if _, err := tree.file.WriteAt(page, int64(node.id)*int64(tree.pageSize)); err != nil {
+		return err
+	}
+	node.dirty = false
+	return nil
+}
+
+// touch moves the node to the front of the cache, i.e. makes it the most recently used one.
+func (tree *Tree) touch(node *node) {
+	if tree.newest == node {
+		return
+	}
+	tree.unlink(node)
+	node.older = tree.newest
+	if tree.newest != nil {
+		tree.newest.newer = node
+	}
+	tree.newest = node
+	if tree.oldest == nil {
+		tree.oldest = node
+	}
+}
+
+// unlink removes the node from the order of the cache.
+func (tree *Tree) unlink(node *node) {
+	if node.newer != nil {
+		node.newer.older = node.older
+	} else if tree.newest == node {
+		tree.newest = node.older
+	}
+	if node.older != nil {
+		node.older.newer = node.newer
+	} else if tree.oldest == node {
+		tree.oldest = node.newer
+	}
+	node.newer, node.older = nil, nil
+}
+
+// evict removes the least recently used nodes from the cache until it holds at most the cache size,
+// writing the modified ones to their pages.
+// Nodes are only evicted between operations, so that a node is never loaded twice while it is being modified.
+func (tree *Tree) evict() {
+	if tree.err != nil {
+		return
+	}
+	for len(tree.cache) > tree.cacheSize && tree.oldest != nil {
+		node := tree.oldest
+		if node.dirty {
+			if err := tree.write(node); err != nil {
+				tree.fail(err)
+				return
+			}
+		}
+		tree.unlink(node)
+		delete(tree.cache, node.id)
+	}
+}
+
+// discard removes the node from the cache without writing it.
+func (tree *Tree) discard(node *node) {
+	tree.unlink(node)
+	delete(tree.cache, node.id)
+}
+
+// allocate returns a page for a new node, reusing the free pages first.
+func (tree *Tree) allocate() uint64 {
+	var id uint64
+	if n := len(tree.free); n > 0 {
+		id, tree.free = tree.free[n-1], tree.free[:n-1]
+	} else {
+		id = tree.pageCount
+		tree.pageCount++
+	}
+	tree.fresh[id] = true
+	return id
+}
+
+// release frees the page of the node, which can be reused right away if it was allocated since the last commit,
+// otherwise only after the next commit, since the committed tree still refers to it.
+func (tree *Tree) release(node *node) {
+	tree.discard(node)
+	if tree.fresh[node.id] {
+		delete(tree.fresh, node.id)
+		tree.free = append(tree.free, node.id)
+	} else {
+		tree.pending = append(tree.pending, node.id)
+	}
+}
+
+// writable prepares the node for modification. Pages of the committed tree are never overwritten, so a node that
+// was not allocated since the last commit is moved to a new page (copy-on-write) and its parent has to refer to it.
+func (tree *Tree) writable(node *node) {
+	if tree.fresh[node.id] {
+		return
+	}
+	tree.discard(node)
+	tree.pending = append(tree.pending, node.id)
+	node.id = tree.allocate()
+	tree.store(node)
+}
+
+// reopen opens the tree from the file, using the newest valid meta page, so that a crash while committing
+// falls back to the previous commit.
+func (tree *Tree) reopen(order int, pageSize int) error {
+	info, err := tree.file.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() == 0 {
+		return tree.initialize(order, pageSize)
+	}
+	current, slot, err := tree.newestMeta(pageSize, info.Size())
+	if err != nil {
+		return err
+	}
+	if current.order != order || current.pageSize != pageSize {
+		return fmt.Errorf("diskbtree: file was created with order %d and page size %d", current.order, current.pageSize)
+	}
+	tree.meta, tree.slot = current, slot
+	tree.root, tree.size, tree.pageCount = current.root, current.size, current.pageCount
+	// Pages of uncommitted changes after the last commit are dropped and all pages not reachable are free
+	if err := tree.file.Truncate(int64(current.pageCount) * int64(pageSize)); err != nil {
+		return err
+	}
+	reachable := make(map[uint64]bool)
+	if err := tree.reach(tree.root, reachable); err != nil {
+		return err
+	}
+	for id := tree.pageCount - 1; id >= metaPages; id-- {
+		if !reachable[id] {
+			tree.free = append(tree.free, id)
+		}
+	}
+	return nil
+}
+
+// reach marks the pages of the subtree as reachable, clearing the cache afterwards.
+func (tree *Tree) reach(id uint64, reachable map[uint64]bool) error {
+	if id == 0 {
+		return nil
+	}
+	if reachable[id] {
+		return fmt.Errorf("%w: page %d is referenced twice", ErrCorrupted, id)
+	}
+	reachable[id] = true
+	node, err := tree.load(id)
+	if err != nil {
+		return err
+	}
+	children := node.children
+	tree.evict()
+	for _, child := range children {
+		if err := tree.reach(child, reachable); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newestMeta reads the meta pages from the file of the given size and returns the valid one with the highest
+// transaction id together with its slot. A meta page is invalid if its checksum does not match or if the file
+// is shorter than the pages it refers to, e.g. after a crash while writing them.
+func (tree *Tree) newestMeta(pageSize int, fileSize int64) (meta, int, error) {
+	var current meta
+	slot := -1
+	for i := 0; i < metaPages; i++ {
+		page := make([]byte, metaSize)
+		var candidate meta
+		if _, err := tree.file.ReadAt(page, int64(i)*int64(pageSize)); err != nil && err != io.EOF {
+			return meta{}, -1, err
+		}
+		if !candidate.decode(page) || int64(candidate.pageCount)*int64(candidate.pageSize) > fileSize {
+			continue
+		}
+		if slot == -1 || candidate.txid > current.txid {
+			current, slot = candidate, i
+		}
+	}
+	if slot == -1 {
+		return meta{}, -1, fmt.Errorf("%w: no valid meta page", ErrCorrupted)
+	}
+	return current, slot, nil
+}
+
+// initialize writes both meta pages of an empty tree to a new file.
+func (tree *Tree) initialize(order int, pageSize int) error {
+	tree.meta = meta{pageSize: pageSize, order: order, pageCount: metaPages}
+	tree.pageCount = metaPages
+	for i := 0; i < metaPages; i++ {
+		if _, err := tree.file.WriteAt(tree.meta.encode(pageSize), int64(i)*int64(pageSize)); err != nil {
+			return err
+		}
+	}
+	return tree.file.Sync()
+}
+
+// commit writes the modified nodes, syncs them to the disk and only then writes and syncs the meta page
+// in the slot of the older commit, so that the previous commit stays intact until the new one is complete.
+func (tree *Tree) commit() error {
+	for _, node := range tree.cache {
+		if node.dirty {
+			if err := tree.write(node); err != nil {
+				return err
+			}
+		}
+	}
+	// The file has to hold all pages, even if the last allocated ones were freed before they were written
+	size := int64(tree.pageCount) * int64(tree.pageSize)
+	info, err := tree.file.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() < size {
+		if err := tree.file.Truncate(size); err != nil {
+			return err
+		}
+	}
+	if err := tree.file.Sync(); err != nil {
+		return err
+	}
+	next := tree.meta
+	next.txid++
+	next.root, next.size, next.pageCount = tree.root, tree.size, tree.pageCount
+	slot := (tree.slot + 1) % metaPages
+	if _, err := tree.file.WriteAt(next.encode(tree.pageSize), int64(slot)*int64(tree.pageSize)); err != nil {
+		return err
+	}
+	if err := tree.file.Sync(); err != nil {
+		return err
+	}
+	tree.meta, tree.slot = next, slot
+	tree.free = append(tree.free, tree.pending...)
+	tree.pending = tree.pending[:0]
+	tree.fresh = make(map[uint64]bool)
+	return nil
+}
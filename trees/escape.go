@@ -0,0 +1,28 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package trees
+
+import "strings"
+
+var (
+	dotEscaper       = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	dotRecordEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "|", `\|`, "{", `\{`, "}", `\}`, "<", `\<`, ">", `\>`)
+	mermaidEscaper   = strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;", "\n", "<br>")
+)
+
+// EscapeDOT escapes the characters of a label that are special in quoted strings of the DOT language.
+func EscapeDOT(label string) string {
+	return dotEscaper.Replace(label)
+}
+
+// EscapeDOTRecord escapes the characters of a field that are special in quoted labels of DOT records.
+func EscapeDOTRecord(field string) string {
+	return dotRecordEscaper.Replace(field)
+}
+
+// EscapeMermaid replaces the characters of a label that Mermaid interprets in quoted node texts by entities.
+func EscapeMermaid(label string) string {
+	return mermaidEscaper.Replace(label)
+}
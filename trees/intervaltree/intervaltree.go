@@ -0,0 +1,218 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package intervaltree implements an interval tree backed by a red-black tree.
+//
+// Elements are closed intervals [start, end] with values, ordered by start and then by end.
+// Each node is augmented with the maximum end of the intervals in its subtree,
+// so that the intervals overlapping a point or a range are found without visiting the whole tree.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Interval_tree#Augmented_tree
+package intervaltree
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/uncle-gua/gods/trees"
+	rbt "github.com/uncle-gua/gods/trees/redblacktree"
+	"github.com/uncle-gua/gods/utils"
+)
+
+// Assert Tree implementation
+var _ trees.Tree = (*Tree)(nil)
+
+// Tree holds the intervals in a red-black tree
+type Tree struct {
+	tree       *rbt.Tree
+	Comparator utils.Comparator // Endpoint comparator
+}
+
+// Interval is a closed interval [Start, End]
+type Interval struct {
+	Start interface{}
+	End   interface{}
+}
+
+// Entry is an interval with its value
+type Entry struct {
+	Interval
+	Value interface{}
+}
+
+// entry is the value of a node in the underlying tree
+type entry struct {
+	value interface{}
+	max   interface{} // maximum end of the intervals in the node's subtree
+}
+
+// NewWith instantiates an interval tree with the custom comparator for the endpoints.
+func NewWith(comparator utils.Comparator) *Tree {
+	tree := &Tree{Comparator: comparator}
+	tree.tree = rbt.NewWith(tree.compare)
+	tree.tree.Augment = tree.augment
+	return tree
+}
+
+// NewWithIntComparator instantiates an interval tree with the IntComparator, i.e. endpoints are of type int.
+func NewWithIntComparator() *Tree {
+	return NewWith(utils.IntComparator)
+}
+
+// NewWithStringComparator instantiates an interval tree with the StringComparator, i.e. endpoints are of type string.
+func NewWithStringComparator() *Tree {
+	return NewWith(utils.StringComparator)
+}
+
+// Put inserts the interval [start, end] with its value into the tree.
+// If the interval already exists, then its value is updated with the new value.
+// Panics if start is greater than end.
+// Endpoints should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Put(start, end interface{}, value interface{}) {
+	if tree.Comparator(start, end) > 0 {
+		panic("Invalid interval, start should not be greater than end")
+	}
+	tree.tree.Put(Interval{Start: start, End: end}, &entry{value: value})
+}
+
+// Get searches the interval [start, end] in the tree and returns its value or nil if interval is not found in tree.
+// Second return parameter is true if interval was found, otherwise false.
+// Endpoints should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Get(start, end interface{}) (value interface{}, found bool) {
+	if value, found := tree.tree.Get(Interval{Start: start, End: end}); found {
+		return value.(*entry).value, true
+	}
+	return nil, false
+}
+
+// Remove removes the interval [start, end] from the tree.
+// Endpoints should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Remove(start, end interface{}) {
+	tree.tree.Remove(Interval{Start: start, End: end})
+}
+
+// Empty returns true if tree does not contain any intervals
+func (tree *Tree) Empty() bool {
+	return tree.tree.Empty()
+}
+
+// Size returns number of intervals in the tree.
+func (tree *Tree) Size() int {
+	return tree.tree.Size()
+}
+
+// Clear removes all intervals from the tree.
+func (tree *Tree) Clear() {
+	tree.tree.Clear()
+}
+
+// Keys returns all intervals in-order
+func (tree *Tree) Keys() []interface{} {
+	return tree.tree.Keys()
+}
+
+// Values returns all values in-order based on the intervals.
+func (tree *Tree) Values() []interface{} {
+	values := make([]interface{}, tree.Size())
+	it := tree.Iterator()
+	for i := 0; it.Next(); i++ {
+		values[i] = it.Value()
+	}
+	return values
+}
+
+// Stab returns the intervals that contain the point with their values, in order.
+// Takes O(min(n, k log n)) time, where k is the number of the returned intervals.
+// Point should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Stab(point interface{}) []Entry {
+	return tree.Overlap(point, point)
+}
+
+// Overlap returns the intervals that overlap the interval [start, end] with their values, in order.
+// Takes O(min(n, k log n)) time, where k is the number of the returned intervals.
+// Endpoints should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Overlap(start, end interface{}) []Entry {
+	entries := []Entry{}
+	tree.overlap(tree.tree.Root, start, end, func(node *rbt.Node) {
+		entries = append(entries, Entry{Interval: node.Key.(Interval), Value: node.Value.(*entry).value})
+	})
+	return entries
+}
+
+// Overlaps returns true if any interval in the tree overlaps the interval [start, end].
+// Takes O(log n) time.
+// Endpoints should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Overlaps(start, end interface{}) bool {
+	node := tree.tree.Root
+	for node != nil {
+		interval := node.Key.(Interval)
+		if tree.Comparator(interval.Start, end) <= 0 && tree.Comparator(start, interval.End) <= 0 {
+			return true
+		}
+		// if the left subtree reaches the start, then it overlaps the interval if anything does,
+		// since the intervals in the right subtree start even later than the ones in the left subtree
+		if node.Left != nil && tree.Comparator(node.Left.Value.(*entry).max, start) >= 0 {
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+	return false
+}
+
+// String returns a string representation of container
+func (tree *Tree) String() string {
+	str := "IntervalTree\n"
+	items := []string{}
+	it := tree.Iterator()
+	for it.Next() {
+		items = append(items, fmt.Sprintf("%v:%v", it.Key(), it.Value()))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
+
+// String returns a string representation of the interval
+func (interval Interval) String() string {
+	return fmt.Sprintf("[%v, %v]", interval.Start, interval.End)
+}
+
+// overlap calls f for the nodes in the subtree whose intervals overlap [start, end], in order.
+// Subtrees whose maximum end is smaller than start are skipped, as are right subtrees of nodes starting after end.
+func (tree *Tree) overlap(node *rbt.Node, start, end interface{}, f func(node *rbt.Node)) {
+	if node == nil || tree.Comparator(node.Value.(*entry).max, start) < 0 {
+		return
+	}
+	tree.overlap(node.Left, start, end, f)
+	interval := node.Key.(Interval)
+	if tree.Comparator(interval.Start, end) > 0 {
+		return
+	}
+	if tree.Comparator(start, interval.End) <= 0 {
+		f(node)
+	}
+	tree.overlap(node.Right, start, end, f)
+}
+
+// compare orders intervals by start and then by end.
+func (tree *Tree) compare(a, b interface{}) int {
+	x, y := a.(Interval), b.(Interval)
+	if compare := tree.Comparator(x.Start, y.Start); compare != 0 {
+		return compare
+	}
+	return tree.Comparator(x.End, y.End)
+}
+
+// augment recomputes the maximum end of the node's subtree from its interval and its children.
+func (tree *Tree) augment(node *rbt.Node) {
+	e := node.Value.(*entry)
+	e.max = node.Key.(Interval).End
+	for _, child := range []*rbt.Node{node.Left, node.Right} {
+		if child != nil && tree.Comparator(child.Value.(*entry).max, e.max) > 0 {
+			e.max = child.Value.(*entry).max
+		}
+	}
+}
@@ -0,0 +1,344 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package intervaltree
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func entriesString(entries []Entry) string {
+	str := ""
+	for _, entry := range entries {
+		str += fmt.Sprintf("%v:%v ", entry.Interval, entry.Value)
+	}
+	return str
+}
+
+func TestIntervalTreePut(t *testing.T) {
+	tree := NewWithIntComparator()
+	tree.Put(5, 10, "a")
+	tree.Put(1, 3, "b")
+	tree.Put(5, 7, "c")
+	tree.Put(12, 12, "d")
+	tree.Put(1, 3, "x") // overwrite
+
+	if actualValue := tree.Size(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), "[[1, 3] [5, 7] [5, 10] [12, 12]]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Values()), "[x c a d]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tests := [][]interface{}{
+		{1, 3, "x", true},
+		{5, 7, "c", true},
+		{5, 10, "a", true},
+		{12, 12, "d", true},
+		{1, 4, nil, false},
+		{7, 5, nil, false},
+	}
+	for _, test := range tests {
+		actualValue, actualFound := tree.Get(test[0], test[1])
+		if actualValue != test[2] || actualFound != test[3] {
+			t.Errorf("Got %v expected %v", actualValue, test[2])
+		}
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Got no panic for an invalid interval")
+		}
+	}()
+	tree.Put(3, 1, "y")
+}
+
+func TestIntervalTreeRemove(t *testing.T) {
+	tree := NewWithIntComparator()
+	tree.Put(5, 10, "a")
+	tree.Put(1, 3, "b")
+	tree.Put(5, 7, "c")
+	tree.Put(12, 12, "d")
+
+	tree.Remove(5, 10)
+	tree.Remove(5, 10)
+	tree.Remove(1, 4)
+
+	if actualValue := tree.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, expectedValue := entriesString(tree.Stab(8)), ""; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tree.Remove(1, 3)
+	tree.Remove(5, 7)
+	tree.Remove(12, 12)
+
+	if actualValue := tree.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := tree.Values(); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+}
+
+func TestIntervalTreeStabAndOverlap(t *testing.T) {
+	tree := NewWithIntComparator()
+	tree.Put(15, 20, "a")
+	tree.Put(10, 30, "b")
+	tree.Put(17, 19, "c")
+	tree.Put(5, 20, "d")
+	tree.Put(12, 15, "e")
+	tree.Put(30, 40, "f")
+
+	tests1 := [][]interface{}{
+		{0, ""},
+		{5, "[5, 20]:d "},
+		{15, "[5, 20]:d [10, 30]:b [12, 15]:e [15, 20]:a "},
+		{18, "[5, 20]:d [10, 30]:b [15, 20]:a [17, 19]:c "},
+		{30, "[10, 30]:b [30, 40]:f "},
+		{41, ""},
+	}
+	for _, test := range tests1 {
+		if actualValue, expectedValue := entriesString(tree.Stab(test[0])), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	tests2 := [][]interface{}{
+		{0, 4, "", false},
+		{0, 5, "[5, 20]:d ", true},
+		{21, 29, "[10, 30]:b ", true},
+		{31, 50, "[30, 40]:f ", true},
+		{41, 50, "", false},
+		{16, 17, "[5, 20]:d [10, 30]:b [15, 20]:a [17, 19]:c ", true},
+	}
+	for _, test := range tests2 {
+		if actualValue, expectedValue := entriesString(tree.Overlap(test[0], test[1])), test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := tree.Overlaps(test[0], test[1]), test[3]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	tree.Remove(10, 30)
+	if actualValue, expectedValue := entriesString(tree.Overlap(21, 29)), ""; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := tree.Overlaps(21, 29), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestIntervalTreeRandom(t *testing.T) {
+	tree := NewWithIntComparator()
+	intervals := map[Interval]int{}
+	random := rand.New(rand.NewSource(3))
+	for i := 0; i < 3000; i++ {
+		start := random.Intn(1000)
+		interval := Interval{Start: start, End: start + random.Intn(50)}
+		if random.Intn(3) == 0 {
+			tree.Remove(interval.Start, interval.End)
+			delete(intervals, interval)
+		} else {
+			tree.Put(interval.Start, interval.End, i)
+			intervals[interval] = i
+		}
+		if i%10 != 0 {
+			continue
+		}
+		start = random.Intn(1100)
+		end := start + random.Intn(20)
+		expected := []Entry{}
+		for interval, value := range intervals {
+			if interval.Start.(int) <= end && start <= interval.End.(int) {
+				expected = append(expected, Entry{Interval: interval, Value: value})
+			}
+		}
+		slices.SortFunc(expected, func(a, b Entry) int {
+			return tree.compare(a.Interval, b.Interval)
+		})
+		if actualValue, expectedValue := entriesString(tree.Overlap(start, end)), entriesString(expected); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := tree.Overlaps(start, end), len(expected) > 0; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestIntervalTreeIterator(t *testing.T) {
+	tree := NewWithIntComparator()
+	tree.Put(3, 4, "c")
+	tree.Put(1, 9, "a")
+	tree.Put(2, 2, "b")
+
+	it := tree.Iterator()
+	for _, expected := range []string{"[1, 9]:a", "[2, 2]:b", "[3, 4]:c"} {
+		if !it.Next() {
+			t.Errorf("Got %v expected %v", false, true)
+		}
+		if actualValue := fmt.Sprintf("%v:%v", it.Key(), it.Value()); actualValue != expected {
+			t.Errorf("Got %v expected %v", actualValue, expected)
+		}
+	}
+	if it.Next() {
+		t.Errorf("Got %v expected %v", true, false)
+	}
+	for _, expected := range []string{"[3, 4]:c", "[2, 2]:b", "[1, 9]:a"} {
+		if !it.Prev() {
+			t.Errorf("Got %v expected %v", false, true)
+		}
+		if actualValue := fmt.Sprintf("%v:%v", it.Key(), it.Value()); actualValue != expected {
+			t.Errorf("Got %v expected %v", actualValue, expected)
+		}
+	}
+	if it.Prev() {
+		t.Errorf("Got %v expected %v", true, false)
+	}
+}
+
+func TestIntervalTreeIteratorRemove(t *testing.T) {
+	tree := NewWithIntComparator()
+	for i := 0; i < 100; i++ {
+		tree.Put(i, i+10, i)
+	}
+	it := tree.Iterator()
+	for it.Next() {
+		if it.Value().(int)%2 == 0 {
+			it.Remove()
+		}
+	}
+	if actualValue := tree.Size(); actualValue != 50 {
+		t.Errorf("Got %v expected %v", actualValue, 50)
+	}
+	if actualValue, expectedValue := entriesString(tree.Stab(105)), "[95, 105]:95 [97, 107]:97 [99, 109]:99 "; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestIntervalTreeSeq(t *testing.T) {
+	tree := NewWithIntComparator()
+	tree.Put(3, 4, "c")
+	tree.Put(1, 9, "a")
+	tree.Put(2, 2, "b")
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(tree.KeysSeq())), "[[1, 9] [2, 2] [3, 4]]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(tree.ValuesSeq())), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	values := []interface{}{}
+	for _, value := range tree.Backward() {
+		values = append(values, value)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", values), "[c b a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestIntervalTreeString(t *testing.T) {
+	tree := NewWithIntComparator()
+	tree.Put(3, 4, "c")
+	tree.Put(1, 9, "a")
+	if actualValue, expectedValue := tree.String(), "IntervalTree\n[1, 9]:a, [3, 4]:c"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func benchmarkStab(b *testing.B, tree *Tree, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			tree.Stab(2 * n)
+		}
+	}
+}
+
+func benchmarkOverlap(b *testing.B, tree *Tree, size int) {
+	for i := 0; i < b.N; i++ {
+		tree.Overlap(0, 2*size)
+	}
+}
+
+// newBenchmarkTree returns a tree of size disjoint intervals [2n, 2n+1], i.e. each point is stabbed by at most one.
+func newBenchmarkTree(size int) *Tree {
+	tree := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		tree.Put(2*n, 2*n+1, struct{}{})
+	}
+	return tree
+}
+
+func BenchmarkIntervalTreeStab100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	tree := newBenchmarkTree(size)
+	b.StartTimer()
+	benchmarkStab(b, tree, size)
+}
+
+func BenchmarkIntervalTreeStab1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	tree := newBenchmarkTree(size)
+	b.StartTimer()
+	benchmarkStab(b, tree, size)
+}
+
+func BenchmarkIntervalTreeStab10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	tree := newBenchmarkTree(size)
+	b.StartTimer()
+	benchmarkStab(b, tree, size)
+}
+
+func BenchmarkIntervalTreeStab100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	tree := newBenchmarkTree(size)
+	b.StartTimer()
+	benchmarkStab(b, tree, size)
+}
+
+func BenchmarkIntervalTreeOverlap100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	tree := newBenchmarkTree(size)
+	b.StartTimer()
+	benchmarkOverlap(b, tree, size)
+}
+
+func BenchmarkIntervalTreeOverlap1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	tree := newBenchmarkTree(size)
+	b.StartTimer()
+	benchmarkOverlap(b, tree, size)
+}
+
+func BenchmarkIntervalTreeOverlap10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	tree := newBenchmarkTree(size)
+	b.StartTimer()
+	benchmarkOverlap(b, tree, size)
+}
+
+func BenchmarkIntervalTreeOverlap100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	tree := newBenchmarkTree(size)
+	b.StartTimer()
+	benchmarkOverlap(b, tree, size)
+}
@@ -0,0 +1,421 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package persistentredblacktree implements a persistent (immutable) red-black tree.
+//
+// Put and Remove do not modify the tree, but return a new version of it. The new version copies only the nodes on the
+// path from the root to the changed node, O(log n) nodes, and shares all other nodes with the old version.
+// Versions never change once created, so keeping a version is a snapshot in constant time, and any version can be
+// read by any number of goroutines concurrently without locking.
+//
+// The tree is a left-leaning red-black tree, which keeps the rebalancing simple enough to be done by path copying.
+//
+// References: https://en.wikipedia.org/wiki/Persistent_data_structure, https://en.wikipedia.org/wiki/Left-leaning_red%E2%80%93black_tree
+package persistentredblacktree
+
+import (
+	"fmt"
+
+	"github.com/uncle-gua/gods/utils"
+)
+
+type color bool
+
+const (
+	black, red color = true, false
+)
+
+// Tree is a version of the persistent red-black tree
+type Tree struct {
+	Root       *Node
+	size       int
+	Comparator utils.Comparator
+}
+
+// Node is a single element within the tree.
+// Nodes are shared between versions of the tree and must not be modified.
+type Node struct {
+	Key   interface{}
+	Value interface{}
+	color color
+	left  *Node
+	right *Node
+}
+
+// NewWith instantiates an empty persistent red-black tree with the custom comparator.
+func NewWith(comparator utils.Comparator) *Tree {
+	return &Tree{Comparator: comparator}
+}
+
+// NewWithIntComparator instantiates an empty persistent red-black tree with the IntComparator, i.e. keys are of type int.
+func NewWithIntComparator() *Tree {
+	return &Tree{Comparator: utils.IntComparator}
+}
+
+// NewWithStringComparator instantiates an empty persistent red-black tree with the StringComparator, i.e. keys are of type string.
+func NewWithStringComparator() *Tree {
+	return &Tree{Comparator: utils.StringComparator}
+}
+
+// Put returns a new version of the tree with the key inserted, or with its value replaced if the key already exists.
+// The tree itself is not modified.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Put(key interface{}, value interface{}) *Tree {
+	if tree.Root == nil {
+		// Assert key is of comparator's type for initial tree
+		tree.Comparator(key, key)
+	}
+	root, added := tree.put(tree.Root, key, value)
+	root.color = black
+	size := tree.size
+	if added {
+		size++
+	}
+	return &Tree{Root: root, size: size, Comparator: tree.Comparator}
+}
+
+// Remove returns a new version of the tree without the key, or the tree itself if the key is not found in tree.
+// The tree itself is not modified.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Remove(key interface{}) *Tree {
+	if tree.lookup(key) == nil {
+		return tree
+	}
+	root := tree.Root.clone()
+	if !isRed(root.left) && !isRed(root.right) {
+		root.color = red
+	}
+	root = tree.remove(root, key)
+	if root != nil {
+		root.color = black
+	}
+	return &Tree{Root: root, size: tree.size - 1, Comparator: tree.Comparator}
+}
+
+// Clear returns an empty version of the tree with the same comparator.
+// The tree itself is not modified.
+func (tree *Tree) Clear() *Tree {
+	return &Tree{Comparator: tree.Comparator}
+}
+
+// Get searches the node in the tree by key and returns its value or nil if key is not found in tree.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Get(key interface{}) (value interface{}, found bool) {
+	node := tree.lookup(key)
+	if node != nil {
+		return node.Value, true
+	}
+	return nil, false
+}
+
+// GetNode searches the node in the tree by key and returns its node or nil if key is not found in tree.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) GetNode(key interface{}) *Node {
+	return tree.lookup(key)
+}
+
+// Empty returns true if tree does not contain any nodes
+func (tree *Tree) Empty() bool {
+	return tree.size == 0
+}
+
+// Size returns number of nodes in the tree.
+func (tree *Tree) Size() int {
+	return tree.size
+}
+
+// Keys returns all keys in-order
+func (tree *Tree) Keys() []interface{} {
+	keys := make([]interface{}, tree.size)
+	it := tree.Iterator()
+	for i := 0; it.Next(); i++ {
+		keys[i] = it.Key()
+	}
+	return keys
+}
+
+// Values returns all values in-order based on the key.
+func (tree *Tree) Values() []interface{} {
+	values := make([]interface{}, tree.size)
+	it := tree.Iterator()
+	for i := 0; it.Next(); i++ {
+		values[i] = it.Value()
+	}
+	return values
+}
+
+// Left returns the left-most (min) node or nil if tree is empty.
+func (tree *Tree) Left() *Node {
+	var parent *Node
+	current := tree.Root
+	for current != nil {
+		parent = current
+		current = current.left
+	}
+	return parent
+}
+
+// Right returns the right-most (max) node or nil if tree is empty.
+func (tree *Tree) Right() *Node {
+	var parent *Node
+	current := tree.Root
+	for current != nil {
+		parent = current
+		current = current.right
+	}
+	return parent
+}
+
+// Floor Finds floor node of the input key, return the floor node or nil if no floor is found.
+// Second return parameter is true if floor was found, otherwise false.
+//
+// Floor node is defined as the largest node that is smaller than or equal to the given node.
+// A floor node may not be found, either because the tree is empty, or because
+// all nodes in the tree are larger than the given node.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Floor(key interface{}) (floor *Node, found bool) {
+	node := tree.Root
+	for node != nil {
+		compare := tree.Comparator(key, node.Key)
+		switch {
+		case compare == 0:
+			return node, true
+		case compare < 0:
+			node = node.left
+		case compare > 0:
+			floor, found = node, true
+			node = node.right
+		}
+	}
+	return floor, found
+}
+
+// Ceiling finds ceiling node of the input key, return the ceiling node or nil if no ceiling is found.
+// Second return parameter is true if ceiling was found, otherwise false.
+//
+// Ceiling node is defined as the smallest node that is larger than or equal to the given node.
+// A ceiling node may not be found, either because the tree is empty, or because
+// all nodes in the tree are smaller than the given node.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Ceiling(key interface{}) (ceiling *Node, found bool) {
+	node := tree.Root
+	for node != nil {
+		compare := tree.Comparator(key, node.Key)
+		switch {
+		case compare == 0:
+			return node, true
+		case compare < 0:
+			ceiling, found = node, true
+			node = node.left
+		case compare > 0:
+			node = node.right
+		}
+	}
+	return ceiling, found
+}
+
+// String returns a string representation of container
+func (tree *Tree) String() string {
+	str := "PersistentRedBlackTree\n"
+	if !tree.Empty() {
+		output(tree.Root, "", true, &str)
+	}
+	return str
+}
+
+// Left returns the left child of the node or nil if it has none.
+func (node *Node) Left() *Node {
+	return node.left
+}
+
+// Right returns the right child of the node or nil if it has none.
+func (node *Node) Right() *Node {
+	return node.right
+}
+
+func (node *Node) String() string {
+	return fmt.Sprintf("%v", node.Key)
+}
+
+func output(node *Node, prefix string, isTail bool, str *string) {
+	if node.right != nil {
+		newPrefix := prefix
+		if isTail {
+			newPrefix += "│   "
+		} else {
+			newPrefix += "    "
+		}
+		output(node.right, newPrefix, false, str)
+	}
+	*str += prefix
+	if isTail {
+		*str += "└── "
+	} else {
+		*str += "┌── "
+	}
+	*str += node.String() + "\n"
+	if node.left != nil {
+		newPrefix := prefix
+		if isTail {
+			newPrefix += "    "
+		} else {
+			newPrefix += "│   "
+		}
+		output(node.left, newPrefix, true, str)
+	}
+}
+
+func (tree *Tree) lookup(key interface{}) *Node {
+	node := tree.Root
+	for node != nil {
+		compare := tree.Comparator(key, node.Key)
+		switch {
+		case compare == 0:
+			return node
+		case compare < 0:
+			node = node.left
+		case compare > 0:
+			node = node.right
+		}
+	}
+	return nil
+}
+
+// The functions below implement the left-leaning red-black tree by path copying: a node is cloned before it is
+// changed, unless it was created by the ongoing modification, i.e. it is not yet shared with any version.
+// The recursive functions take and return such unshared nodes.
+
+// put inserts the key into the subtree and returns its new root and whether the key was added.
+func (tree *Tree) put(node *Node, key interface{}, value interface{}) (*Node, bool) {
+	if node == nil {
+		return &Node{Key: key, Value: value, color: red}, true
+	}
+	node = node.clone()
+	var added bool
+	compare := tree.Comparator(key, node.Key)
+	switch {
+	case compare == 0:
+		node.Key = key
+		node.Value = value
+	case compare < 0:
+		node.left, added = tree.put(node.left, key, value)
+	case compare > 0:
+		node.right, added = tree.put(node.right, key, value)
+	}
+	return balance(node), added
+}
+
+// remove removes the key, which must be in the subtree of the unshared node, and returns the subtree's new root.
+func (tree *Tree) remove(node *Node, key interface{}) *Node {
+	if tree.Comparator(key, node.Key) < 0 {
+		if !isRed(node.left) && !isRed(node.left.left) {
+			node = moveRedLeft(node)
+		}
+		node.left = tree.remove(node.left.clone(), key)
+	} else {
+		if isRed(node.left) {
+			node = rotateRight(node)
+		}
+		if tree.Comparator(key, node.Key) == 0 && node.right == nil {
+			return nil
+		}
+		if !isRed(node.right) && !isRed(node.right.left) {
+			node = moveRedRight(node)
+		}
+		if tree.Comparator(key, node.Key) == 0 {
+			min := node.right
+			for min.left != nil {
+				min = min.left
+			}
+			node.Key = min.Key
+			node.Value = min.Value
+			node.right = removeMin(node.right.clone())
+		} else {
+			node.right = tree.remove(node.right.clone(), key)
+		}
+	}
+	return balance(node)
+}
+
+// removeMin removes the minimum of the subtree of the unshared node and returns the subtree's new root.
+func removeMin(node *Node) *Node {
+	if node.left == nil {
+		return nil
+	}
+	if !isRed(node.left) && !isRed(node.left.left) {
+		node = moveRedLeft(node)
+	}
+	node.left = removeMin(node.left.clone())
+	return balance(node)
+}
+
+func moveRedLeft(node *Node) *Node {
+	flipColors(node)
+	if isRed(node.right.left) {
+		node.right = rotateRight(node.right)
+		node = rotateLeft(node)
+		flipColors(node)
+	}
+	return node
+}
+
+func moveRedRight(node *Node) *Node {
+	flipColors(node)
+	if isRed(node.left.left) {
+		node = rotateRight(node)
+		flipColors(node)
+	}
+	return node
+}
+
+func balance(node *Node) *Node {
+	if isRed(node.right) && !isRed(node.left) {
+		node = rotateLeft(node)
+	}
+	if isRed(node.left) && isRed(node.left.left) {
+		node = rotateRight(node)
+	}
+	if isRed(node.left) && isRed(node.right) {
+		flipColors(node)
+	}
+	return node
+}
+
+func rotateLeft(node *Node) *Node {
+	right := node.right.clone()
+	node.right = right.left
+	right.left = node
+	right.color = node.color
+	node.color = red
+	return right
+}
+
+func rotateRight(node *Node) *Node {
+	left := node.left.clone()
+	node.left = left.right
+	left.right = node
+	left.color = node.color
+	node.color = red
+	return left
+}
+
+func flipColors(node *Node) {
+	node.color = !node.color
+	node.left = node.left.clone()
+	node.left.color = !node.left.color
+	node.right = node.right.clone()
+	node.right.color = !node.right.color
+}
+
+func (node *Node) clone() *Node {
+	clone := *node
+	return &clone
+}
+
+func isRed(node *Node) bool {
+	return node != nil && node.color == red
+}
@@ -0,0 +1,402 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package persistentredblacktree
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// blackHeight returns the number of black nodes on every path from the node down to a leaf,
+// failing if the subtree is not a left-leaning red-black tree.
+func blackHeight(t *testing.T, tree *Tree, node *Node, min, max interface{}) int {
+	if node == nil {
+		return 1
+	}
+	if min != nil && tree.Comparator(node.Key, min) <= 0 || max != nil && tree.Comparator(node.Key, max) >= 0 {
+		t.Fatalf("Key %v out of order", node.Key)
+	}
+	if isRed(node.right) {
+		t.Fatalf("Right-leaning red link at %v", node.Key)
+	}
+	if isRed(node) && isRed(node.left) {
+		t.Fatalf("Two red links in a row at %v", node.Key)
+	}
+	left, right := blackHeight(t, tree, node.left, min, node.Key), blackHeight(t, tree, node.right, node.Key, max)
+	if left != right {
+		t.Fatalf("Unbalanced black height at %v: %v and %v", node.Key, left, right)
+	}
+	if isRed(node) {
+		return left
+	}
+	return left + 1
+}
+
+func TestPersistentRedBlackTreePut(t *testing.T) {
+	tree0 := NewWithIntComparator()
+	tree1 := tree0.Put(5, "e")
+	tree2 := tree1.Put(6, "f").Put(7, "g").Put(3, "c").Put(4, "d").Put(1, "x").Put(2, "b")
+	tree3 := tree2.Put(1, "a") // overwrite
+
+	tests := [][]interface{}{
+		{tree0, 0, "[]", "[]"},
+		{tree1, 1, "[5]", "[e]"},
+		{tree2, 7, "[1 2 3 4 5 6 7]", "[x b c d e f g]"},
+		{tree3, 7, "[1 2 3 4 5 6 7]", "[a b c d e f g]"},
+	}
+	for _, test := range tests {
+		tree := test[0].(*Tree)
+		if actualValue, expectedValue := tree.Size(), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", tree.Values()), test[3]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		blackHeight(t, tree, tree.Root, nil, nil)
+	}
+
+	if actualValue, found := tree2.Get(1); actualValue != "x" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "x")
+	}
+	if actualValue, found := tree3.Get(1); actualValue != "a" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue, found := tree3.Get(8); actualValue != nil || found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestPersistentRedBlackTreeRemove(t *testing.T) {
+	tree1 := NewWithIntComparator()
+	for i := 1; i <= 7; i++ {
+		tree1 = tree1.Put(i, i)
+	}
+	tree2 := tree1.Remove(5).Remove(6).Remove(7).Remove(8)
+	tree3 := tree2.Remove(1).Remove(2).Remove(3).Remove(4)
+
+	tests := [][]interface{}{
+		{tree1, 7, "[1 2 3 4 5 6 7]"},
+		{tree2, 4, "[1 2 3 4]"},
+		{tree3, 0, "[]"},
+	}
+	for _, test := range tests {
+		tree := test[0].(*Tree)
+		if actualValue, expectedValue := tree.Size(), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		blackHeight(t, tree, tree.Root, nil, nil)
+	}
+
+	if actualValue := tree3.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := tree3.Remove(1); actualValue != tree3 {
+		t.Errorf("Got %v expected %v", actualValue, tree3)
+	}
+	if actualValue := tree1.Clear(); !actualValue.Empty() || tree1.Empty() {
+		t.Errorf("Got %v expected %v", actualValue, "empty tree and unchanged tree")
+	}
+}
+
+func TestPersistentRedBlackTreeRandom(t *testing.T) {
+	type snapshot struct {
+		tree *Tree
+		keys string
+	}
+	snapshots := []snapshot{}
+	tree := NewWithIntComparator()
+	keys := map[int]bool{}
+	random := rand.New(rand.NewSource(3))
+	for i := 0; i < 5000; i++ {
+		key := random.Intn(300)
+		if random.Intn(3) == 0 {
+			tree = tree.Remove(key)
+			delete(keys, key)
+		} else {
+			tree = tree.Put(key, i)
+			keys[key] = true
+		}
+		blackHeight(t, tree, tree.Root, nil, nil)
+		if actualValue, expectedValue := tree.Size(), len(keys); actualValue != expectedValue {
+			t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if i%100 == 0 {
+			expected := []int{}
+			for key := range keys {
+				expected = append(expected, key)
+			}
+			slices.Sort(expected)
+			snapshots = append(snapshots, snapshot{tree: tree, keys: fmt.Sprintf("%v", expected)})
+		}
+	}
+	for _, snapshot := range snapshots {
+		if actualValue, expectedValue := fmt.Sprintf("%v", snapshot.tree.Keys()), snapshot.keys; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		blackHeight(t, snapshot.tree, snapshot.tree.Root, nil, nil)
+	}
+}
+
+func TestPersistentRedBlackTreeConcurrentReads(t *testing.T) {
+	tree := NewWithIntComparator()
+	for i := 0; i < 1000; i++ {
+		tree = tree.Put(i, i)
+	}
+	snapshot := tree
+
+	var wg sync.WaitGroup
+	errors := make(chan string, 8)
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for round := 0; round < 10; round++ {
+				count := 0
+				for key, value := range snapshot.AllSeq() {
+					if key != count || value != count {
+						errors <- fmt.Sprintf("Got %v:%v expected %v:%v", key, value, count, count)
+						return
+					}
+					count++
+				}
+				if count != 1000 {
+					errors <- fmt.Sprintf("Got %v expected %v", count, 1000)
+					return
+				}
+			}
+		}()
+	}
+	for i := 0; i < 1000; i += 2 {
+		tree = tree.Remove(i).Put(i+1000, i)
+	}
+	wg.Wait()
+	close(errors)
+	for err := range errors {
+		t.Error(err)
+	}
+	if actualValue, expectedValue := tree.Size(), 1000; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestPersistentRedBlackTreeLeftAndRight(t *testing.T) {
+	tree := NewWithIntComparator()
+
+	if actualValue := tree.Left(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := tree.Right(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	tree = tree.Put(1, "a").Put(5, "e").Put(6, "f").Put(7, "g").Put(3, "c").Put(4, "d").Put(1, "x").Put(2, "b")
+
+	if actualValue, expectedValue := tree.Left().Key, 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := tree.Right().Value, "g"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestPersistentRedBlackTreeNodeChildren(t *testing.T) {
+	tree := newIteratorTestTree()
+	var keys []interface{}
+	var inOrder func(node *Node)
+	inOrder = func(node *Node) {
+		if node == nil {
+			return
+		}
+		inOrder(node.Left())
+		keys = append(keys, node.Key)
+		inOrder(node.Right())
+	}
+	inOrder(tree.Root)
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[1 2 3 4 5 6 7]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestPersistentRedBlackTreeCeilingAndFloor(t *testing.T) {
+	tree := NewWithIntComparator()
+
+	if node, found := tree.Floor(0); node != nil || found {
+		t.Errorf("Got %v expected %v", node, "<nil>")
+	}
+	if node, found := tree.Ceiling(0); node != nil || found {
+		t.Errorf("Got %v expected %v", node, "<nil>")
+	}
+
+	tree = tree.Put(5, "e").Put(6, "f").Put(7, "g").Put(3, "c").Put(1, "x")
+
+	if node, found := tree.Floor(4); node.Key != 3 || !found {
+		t.Errorf("Got %v expected %v", node.Key, 3)
+	}
+	if node, found := tree.Floor(0); node != nil || found {
+		t.Errorf("Got %v expected %v", node, "<nil>")
+	}
+	if node, found := tree.Ceiling(4); node.Key != 5 || !found {
+		t.Errorf("Got %v expected %v", node.Key, 5)
+	}
+	if node, found := tree.Ceiling(8); node != nil || found {
+		t.Errorf("Got %v expected %v", node, "<nil>")
+	}
+}
+
+func newIteratorTestTree() *Tree {
+	tree := NewWithIntComparator()
+	for _, key := range []int{5, 6, 7, 3, 4, 1, 2} {
+		tree = tree.Put(key, fmt.Sprintf("%d", key))
+	}
+	return tree
+}
+
+func TestPersistentRedBlackTreeIterator(t *testing.T) {
+	tree := NewWithIntComparator()
+	it := tree.Iterator()
+	if it.Next() || it.Prev() || it.First() || it.Last() {
+		t.Errorf("Shouldn't iterate on empty tree")
+	}
+
+	it = newIteratorTestTree().Iterator()
+	keys := []interface{}{}
+	for it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[1 2 3 4 5 6 7]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	keys = []interface{}{}
+	for it.Prev() {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[7 6 5 4 3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestPersistentRedBlackTreeIteratorFirstLastTo(t *testing.T) {
+	tree := newIteratorTestTree()
+	it := tree.Iterator()
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue || it.Key() != 7 {
+		t.Errorf("Got %v expected %v", it.Key(), 7)
+	}
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue || it.Key() != 1 {
+		t.Errorf("Got %v expected %v", it.Key(), 1)
+	}
+	if actualValue, expectedValue := it.NextTo(func(key interface{}, value interface{}) bool {
+		return value == "4"
+	}), true; actualValue != expectedValue || it.Key() != 4 {
+		t.Errorf("Got %v expected %v", it.Key(), 4)
+	}
+
+	// the iterator keeps moving over its own version of the tree
+	newer := tree.Remove(3).Put(8, "8")
+	if actualValue, expectedValue := it.PrevTo(func(key interface{}, value interface{}) bool {
+		return key.(int) < 4
+	}), true; actualValue != expectedValue || it.Key() != 3 {
+		t.Errorf("Got %v expected %v", it.Key(), 3)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", newer.Keys()), "[1 2 4 5 6 7 8]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestPersistentRedBlackTreeIteratorClone(t *testing.T) {
+	it := newIteratorTestTree().Iterator()
+	it.NextTo(func(key interface{}, value interface{}) bool {
+		return key == 4
+	})
+	clone := it.Clone()
+	clone.Next()
+	if actualValue, expectedValue := it.Key(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := clone.Key(), 5; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestPersistentRedBlackTreeIteratorNode(t *testing.T) {
+	it := newIteratorTestTree().Iterator()
+	it.End()
+	if actualValue := it.Node(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	it.Begin()
+	if actualValue, expectedValue := it.Next(), true; actualValue != expectedValue || it.Node().Key != 1 {
+		t.Errorf("Got %v expected %v", it.Node(), 1)
+	}
+}
+
+func TestPersistentRedBlackTreeSeq(t *testing.T) {
+	tree := NewWithIntComparator().Put(2, "b").Put(1, "a").Put(3, "c")
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(tree.KeysSeq())), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(tree.ValuesSeq())), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	keys := []interface{}{}
+	for key := range tree.Backward() {
+		keys = append(keys, key)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestPersistentRedBlackTreeString(t *testing.T) {
+	tree := NewWithIntComparator().Put(2, "b").Put(1, "a").Put(3, "c")
+	if actualValue, expectedValue := tree.String(), "PersistentRedBlackTree\n│   ┌── 3\n└── 2\n    └── 1\n"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func benchmarkPut(b *testing.B, tree *Tree, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			tree = tree.Put(n, struct{}{})
+		}
+	}
+}
+
+func benchmarkRemove(b *testing.B, tree *Tree, size int) {
+	for i := 0; i < b.N; i++ {
+		removed := tree
+		for n := 0; n < size; n++ {
+			removed = removed.Remove(n)
+		}
+	}
+}
+
+func BenchmarkPersistentRedBlackTreePut1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	tree := NewWithIntComparator()
+	b.StartTimer()
+	benchmarkPut(b, tree, size)
+}
+
+func BenchmarkPersistentRedBlackTreeRemove1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	tree := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		tree = tree.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkRemove(b, tree, size)
+}
@@ -4,7 +4,11 @@
 
 package redblacktree
 
-import "github.com/uncle-gua/gods/containers"
+import (
+	"iter"
+
+	"github.com/uncle-gua/gods/containers"
+)
 
 // Assert Iterator implementation
 var _ containers.ReverseIteratorWithKey = (*Iterator)(nil)
@@ -14,6 +18,10 @@ type Iterator struct {
 	tree     *Tree
 	node     *Node
 	position position
+	removed  bool
+	bounded  bool
+	from, to interface{}
+	failFast containers.FailFast
 }
 
 type position byte
@@ -23,13 +31,34 @@ const (
 )
 
 // Iterator returns a stateful iterator whose elements are key/value pairs.
+// The iterator fails fast: moving it after the tree was structurally modified other than through the iterator
+// panics with containers.ErrConcurrentModification. Modifications made while the iterator is before the first
+// or past the last element, e.g. after Begin() or End(), are allowed.
 func (tree *Tree) Iterator() Iterator {
-	return Iterator{tree: tree, node: nil, position: begin}
+	return Iterator{tree: tree, node: nil, position: begin, failFast: containers.NewFailFast(tree.modCount)}
+}
+
+// CheckedIterator returns a stateful iterator like Iterator(), except that instead of panicking when the tree was
+// modified during iteration, it stops and reports containers.ErrConcurrentModification by Err().
+func (tree *Tree) CheckedIterator() Iterator {
+	iterator := tree.Iterator()
+	iterator.failFast.Check()
+	return iterator
+}
+
+// RangeIterator returns a stateful iterator like Iterator(), restricted to the elements whose keys are in the
+// half-open range [from, to), i.e. it moves from its initial state to the first element not smaller than from,
+// and past its last element when the next element would not be smaller than to (and vice versa in reverse).
+// Keys should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) RangeIterator(from, to interface{}) Iterator {
+	iterator := tree.Iterator()
+	iterator.bounded, iterator.from, iterator.to = true, from, to
+	return iterator
 }
 
 // IteratorAt returns a stateful iterator whose elements are key/value pairs that is initialised at a particular node.
 func (tree *Tree) IteratorAt(node *Node) Iterator {
-	return Iterator{tree: tree, node: node, position: between}
+	return Iterator{tree: tree, node: node, position: between, failFast: containers.NewFailFast(tree.modCount)}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -37,11 +66,22 @@ func (tree *Tree) IteratorAt(node *Node) Iterator {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator) Next() bool {
+	if !iterator.failFast.Move(iterator.tree.modCount, iterator.position != between) {
+		return false
+	}
+	if iterator.removed {
+		// the node is the successor of the removed element
+		iterator.removed = false
+		if iterator.node == nil {
+			goto end
+		}
+		goto between
+	}
 	if iterator.position == end {
 		goto end
 	}
 	if iterator.position == begin {
-		left := iterator.tree.Left()
+		left := iterator.first()
 		if left == nil {
 			goto end
 		}
@@ -69,6 +109,9 @@ end:
 	return false
 
 between:
+	if !iterator.withinBounds(iterator.node.Key) {
+		goto end
+	}
 	iterator.position = between
 	return true
 }
@@ -77,11 +120,21 @@ between:
 // If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator) Prev() bool {
+	if !iterator.failFast.Move(iterator.tree.modCount, iterator.position != between) {
+		return false
+	}
+	if iterator.removed {
+		// the node is the successor of the removed element, its predecessor is the element to move to
+		iterator.removed = false
+		if iterator.node == nil {
+			iterator.position = end
+		}
+	}
 	if iterator.position == begin {
 		goto begin
 	}
 	if iterator.position == end {
-		right := iterator.tree.Right()
+		right := iterator.last()
 		if right == nil {
 			goto begin
 		}
@@ -109,6 +162,9 @@ begin:
 	return false
 
 between:
+	if !iterator.withinBounds(iterator.node.Key) {
+		goto begin
+	}
 	iterator.position = between
 	return true
 }
@@ -131,11 +187,20 @@ func (iterator *Iterator) Node() *Node {
 	return iterator.node
 }
 
+// Clone returns an independent copy of the iterator at the same position, e.g. for lookahead or backtracking.
+// Moving either of the iterators does not move the other one.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Clone() Iterator {
+	return *iterator
+}
+
 // Begin resets the iterator to its initial state (one-before-first)
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator) Begin() {
 	iterator.node = nil
 	iterator.position = begin
+	iterator.removed = false
+	iterator.sync()
 }
 
 // End moves the iterator past the last element (one-past-the-end).
@@ -143,6 +208,26 @@ func (iterator *Iterator) Begin() {
 func (iterator *Iterator) End() {
 	iterator.node = nil
 	iterator.position = end
+	iterator.removed = false
+	iterator.sync()
+}
+
+// Remove removes the current element from the tree in amortized O(log n) time.
+// Afterwards the iterator is between the neighbours of the removed element, i.e. Next() moves to the element that
+// followed it and Prev() to the element that preceded it, Key(), Value() and Node() are undefined until then.
+// Returns false and does nothing if the iterator is not at an element.
+func (iterator *Iterator) Remove() bool {
+	if iterator.position != between || iterator.removed || iterator.modified() {
+		return false
+	}
+	successor := *iterator
+	successor.Next()
+	iterator.tree.removeNode(iterator.node)
+	// removing a node relinks, but never discards, the successor's node
+	iterator.node = successor.node
+	iterator.removed = true
+	iterator.failFast.Sync(iterator.tree.modCount)
+	return true
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -188,3 +273,151 @@ func (iterator *Iterator) PrevTo(f func(key interface{}, value interface{}) bool
 	}
 	return false
 }
+
+// SeekGE moves the iterator to the first element whose key is greater than or equal to the given key and returns
+// true if there was such an element, otherwise moves the iterator past the last element.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekGE(key interface{}) bool {
+	return iterator.seekForward(iterator.tree.ceilingNode(key, true))
+}
+
+// SeekGT moves the iterator to the first element whose key is greater than the given key and returns
+// true if there was such an element, otherwise moves the iterator past the last element.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekGT(key interface{}) bool {
+	return iterator.seekForward(iterator.tree.ceilingNode(key, false))
+}
+
+// SeekLE moves the iterator to the last element whose key is smaller than or equal to the given key and returns
+// true if there was such an element, otherwise moves the iterator before the first element.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekLE(key interface{}) bool {
+	return iterator.seekBackward(iterator.tree.floorNode(key, true))
+}
+
+// SeekLT moves the iterator to the last element whose key is smaller than the given key and returns
+// true if there was such an element, otherwise moves the iterator before the first element.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekLT(key interface{}) bool {
+	return iterator.seekBackward(iterator.tree.floorNode(key, false))
+}
+
+// seekForward moves the iterator to the node, or to the first node if the node is below the range of the iterator.
+func (iterator *Iterator) seekForward(node *Node) bool {
+	iterator.removed = false
+	iterator.sync()
+	if node != nil && iterator.bounded && iterator.tree.Comparator(node.Key, iterator.from) < 0 {
+		node = iterator.first()
+	}
+	if node == nil || !iterator.withinBounds(node.Key) {
+		iterator.node, iterator.position = nil, end
+		return false
+	}
+	iterator.node, iterator.position = node, between
+	return true
+}
+
+// seekBackward moves the iterator to the node, or to the last node if the node is above the range of the iterator.
+func (iterator *Iterator) seekBackward(node *Node) bool {
+	iterator.removed = false
+	iterator.sync()
+	if node != nil && iterator.bounded && iterator.tree.Comparator(node.Key, iterator.to) >= 0 {
+		node = iterator.last()
+	}
+	if node == nil || !iterator.withinBounds(node.Key) {
+		iterator.node, iterator.position = nil, begin
+		return false
+	}
+	iterator.node, iterator.position = node, between
+	return true
+}
+
+// first returns the first node within the range of the iterator.
+func (iterator *Iterator) first() *Node {
+	if iterator.bounded {
+		return iterator.tree.ceilingNode(iterator.from, true)
+	}
+	return iterator.tree.Left()
+}
+
+// last returns the last node within the range of the iterator.
+func (iterator *Iterator) last() *Node {
+	if iterator.bounded {
+		return iterator.tree.floorNode(iterator.to, false)
+	}
+	return iterator.tree.Right()
+}
+
+// withinBounds returns true if the key is within the range of the iterator.
+func (iterator *Iterator) withinBounds(key interface{}) bool {
+	return !iterator.bounded ||
+		iterator.tree.Comparator(key, iterator.from) >= 0 && iterator.tree.Comparator(key, iterator.to) < 0
+}
+
+// Err returns containers.ErrConcurrentModification if the checked iterator stopped because the tree was modified
+// during iteration, otherwise nil.
+func (iterator *Iterator) Err() error {
+	return iterator.failFast.Err()
+}
+
+// sync accepts the current state of the tree, i.e. after the iterator was reset.
+func (iterator *Iterator) sync() {
+	iterator.failFast.Sync(iterator.tree.modCount)
+}
+
+// modified returns true if the tree was modified since the iterator was synchronised with it.
+// Panics with containers.ErrConcurrentModification, unless the iterator is checked.
+func (iterator *Iterator) modified() bool {
+	return iterator.failFast.Modified(iterator.tree.modCount)
+}
+
+// AllSeq returns an iterator over key-value pairs of the tree in order, for use with range-over-func.
+func (tree *Tree) AllSeq() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		iterator := tree.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Key(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns an iterator over keys of the tree in order, for use with range-over-func.
+// It is not named Keys, which returns the keys as a slice.
+func (tree *Tree) KeysSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		iterator := tree.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Key()) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over values of the tree in order of their keys, for use with range-over-func.
+// It is not named Values, which returns the values as a slice.
+func (tree *Tree) ValuesSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		iterator := tree.Iterator()
+		for iterator.Next() {
+			if !yield(iterator.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over key-value pairs of the tree in reverse order, for use with range-over-func.
+func (tree *Tree) Backward() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		iterator := tree.Iterator()
+		iterator.End()
+		for iterator.Prev() {
+			if !yield(iterator.Key(), iterator.Value()) {
+				return
+			}
+		}
+	}
+}
@@ -12,13 +12,16 @@
 package redblacktree
 
 import (
+	"bytes"
 	"fmt"
+	"github.com/uncle-gua/gods/containers"
 	"github.com/uncle-gua/gods/trees"
 	"github.com/uncle-gua/gods/utils"
 )
 
 // Assert Tree implementation
 var _ trees.Tree = (*Tree)(nil)
+var _ containers.Validator = (*Tree)(nil)
 
 type color bool
 
@@ -31,6 +34,12 @@ type Tree struct {
 	Root       *Node
 	size       int
 	Comparator utils.Comparator
+	modCount   int // number of structural modifications, checked by iterators
+
+	// Augment, if set, is called for a node whenever its subtree or its value changed, after it was called for
+	// the changed children, so that data derived from the subtree can be kept in the nodes' values,
+	// e.g. the maximum endpoint of the intervals in the subtree.
+	Augment func(node *Node)
 }
 
 // Node is a single element within the tree
@@ -38,6 +47,7 @@ type Node struct {
 	Key    interface{}
 	Value  interface{}
 	color  color
+	size   int // number of nodes in the subtree rooted at this node
 	Left   *Node
 	Right  *Node
 	Parent *Node
@@ -58,6 +68,57 @@ func NewWithStringComparator() *Tree {
 	return &Tree{Comparator: utils.StringComparator}
 }
 
+// NewFromSorted instantiates a red-black tree with the custom comparator from keys in strictly increasing order and
+// their values in O(n) time, i.e. without rebalancing.
+// Returns an error wrapping trees.ErrUnsorted if the keys are not in strictly increasing order.
+func NewFromSorted(comparator utils.Comparator, keys []interface{}, values []interface{}) (*Tree, error) {
+	tree := NewWith(comparator)
+	if err := tree.LoadSorted(keys, values); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+// NewFromIterator instantiates a red-black tree with the custom comparator from the remaining elements of an
+// iterator whose keys are in strictly increasing order, e.g. one of an ordered map, in O(n) time.
+// Returns an error wrapping trees.ErrUnsorted if the keys are not in strictly increasing order.
+func NewFromIterator(comparator utils.Comparator, iterator containers.IteratorWithKey) (*Tree, error) {
+	keys, values := []interface{}{}, []interface{}{}
+	for iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	return NewFromSorted(comparator, keys, values)
+}
+
+// LoadSorted replaces all nodes of the tree by keys in strictly increasing order and their values in O(n) time,
+// i.e. without rebalancing.
+// Returns an error wrapping trees.ErrUnsorted if the keys are not in strictly increasing order,
+// in which case the tree is not modified.
+// Keys should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) LoadSorted(keys []interface{}, values []interface{}) error {
+	if len(keys) != len(values) {
+		return fmt.Errorf("redblacktree: got %d keys and %d values", len(keys), len(values))
+	}
+	for i := 1; i < len(keys); i++ {
+		if tree.Comparator(keys[i-1], keys[i]) >= 0 {
+			return fmt.Errorf("%w: key %v at index %d is not greater than key %v", trees.ErrUnsorted, keys[i], i, keys[i-1])
+		}
+	}
+	if len(keys) == 1 {
+		// Assert key is of comparator's type
+		tree.Comparator(keys[0], keys[0])
+	}
+	depth := -1 // depth of the deepest nodes
+	for n := len(keys); n > 0; n >>= 1 {
+		depth++
+	}
+	tree.Root = tree.build(keys, values, nil, 0, depth)
+	tree.size = len(keys)
+	tree.modCount++
+	return nil
+}
+
 // Put inserts node into the tree.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (tree *Tree) Put(key interface{}, value interface{}) {
@@ -65,7 +126,7 @@ func (tree *Tree) Put(key interface{}, value interface{}) {
 	if tree.Root == nil {
 		// Assert key is of comparator's type for initial tree
 		tree.Comparator(key, key)
-		tree.Root = &Node{Key: key, Value: value, color: red}
+		tree.Root = &Node{Key: key, Value: value, color: red, size: 1}
 		insertedNode = tree.Root
 	} else {
 		node := tree.Root
@@ -76,10 +137,11 @@ func (tree *Tree) Put(key interface{}, value interface{}) {
 			case compare == 0:
 				node.Key = key
 				node.Value = value
+				tree.augmentPath(node)
 				return
 			case compare < 0:
 				if node.Left == nil {
-					node.Left = &Node{Key: key, Value: value, color: red}
+					node.Left = &Node{Key: key, Value: value, color: red, size: 1}
 					insertedNode = node.Left
 					loop = false
 				} else {
@@ -87,7 +149,7 @@ func (tree *Tree) Put(key interface{}, value interface{}) {
 				}
 			case compare > 0:
 				if node.Right == nil {
-					node.Right = &Node{Key: key, Value: value, color: red}
+					node.Right = &Node{Key: key, Value: value, color: red, size: 1}
 					insertedNode = node.Right
 					loop = false
 				} else {
@@ -96,9 +158,15 @@ func (tree *Tree) Put(key interface{}, value interface{}) {
 			}
 		}
 		insertedNode.Parent = node
+		for node != nil {
+			node.size++
+			node = node.Parent
+		}
 	}
+	tree.augmentPath(insertedNode)
 	tree.insertCase1(insertedNode)
 	tree.size++
+	tree.modCount++
 }
 
 // Get searches the node in the tree by key and returns its value or nil if key is not found in tree.
@@ -121,11 +189,17 @@ func (tree *Tree) GetNode(key interface{}) *Node {
 // Remove remove the node from the tree by key.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (tree *Tree) Remove(key interface{}) {
-	var child *Node
 	node := tree.lookup(key)
 	if node == nil {
 		return
 	}
+	tree.removeNode(node)
+}
+
+// removeNode removes the node from the tree.
+// If the node has two children, its predecessor's key and value are moved into it and the predecessor is removed instead.
+func (tree *Tree) removeNode(node *Node) {
+	var child *Node
 	if node.Left != nil && node.Right != nil {
 		pred := node.Left.maximumNode()
 		node.Key = pred.Key
@@ -138,6 +212,10 @@ func (tree *Tree) Remove(key interface{}) {
 		} else {
 			child = node.Right
 		}
+		// the node no longer counts, so that rotations while rebalancing see the sizes after removal
+		for parent := node; parent != nil; parent = parent.Parent {
+			parent.size--
+		}
 		if node.color == black {
 			node.color = nodeColor(child)
 			tree.deleteCase1(node)
@@ -146,8 +224,10 @@ func (tree *Tree) Remove(key interface{}) {
 		if node.Parent == nil && child != nil {
 			child.color = black
 		}
+		tree.augmentPath(node.Parent)
 	}
 	tree.size--
+	tree.modCount++
 }
 
 // Empty returns true if tree does not contain any nodes
@@ -161,19 +241,60 @@ func (tree *Tree) Size() int {
 }
 
 // Size returns the number of elements stored in the subtree.
-// Computed dynamically on each call, i.e. the subtree is traversed to count the number of the nodes.
+// The size is maintained by the tree on every modification, so this takes constant time.
 func (node *Node) Size() int {
 	if node == nil {
 		return 0
 	}
-	size := 1
-	if node.Left != nil {
-		size += node.Left.Size()
+	return node.size
+}
+
+// Rank returns the number of keys in the tree that are smaller than the given key in O(log n) time,
+// i.e. the index the key has or would have if it were put into the tree.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Rank(key interface{}) int {
+	rank, _ := tree.rank(key)
+	return rank
+}
+
+// IndexOf returns the index of the key in the in-order sequence of keys in O(log n) time,
+// or -1 if key is not found in tree.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) IndexOf(key interface{}) int {
+	if rank, found := tree.rank(key); found {
+		return rank
 	}
-	if node.Right != nil {
-		size += node.Right.Size()
+	return -1
+}
+
+// GetAt returns the node at the given index in the in-order sequence of nodes in O(log n) time.
+// Second return parameter is true if index is within bounds, otherwise false.
+func (tree *Tree) GetAt(index int) (node *Node, found bool) {
+	if index < 0 || index >= tree.size {
+		return nil, false
+	}
+	node = tree.Root
+	for {
+		left := node.Left.Size()
+		switch {
+		case index < left:
+			node = node.Left
+		case index == left:
+			return node, true
+		default:
+			index -= left + 1
+			node = node.Right
+		}
+	}
+}
+
+// CountRange returns the number of keys in the half-open range [from, to) in O(log n) time.
+// Keys should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) CountRange(from, to interface{}) int {
+	if tree.Comparator(from, to) >= 0 {
+		return 0
 	}
-	return size
+	return tree.Rank(to) - tree.Rank(from)
 }
 
 // Keys returns all keys in-order
@@ -276,10 +397,98 @@ func (tree *Tree) Ceiling(key interface{}) (ceiling *Node, found bool) {
 	return nil, false
 }
 
+// ceilingNode returns the smallest node whose key is greater than (or, if inclusive, equal to) the given key,
+// or nil if there is no such node.
+func (tree *Tree) ceilingNode(key interface{}, inclusive bool) *Node {
+	var ceiling *Node
+	node := tree.Root
+	for node != nil {
+		compare := tree.Comparator(key, node.Key)
+		if compare < 0 || compare == 0 && inclusive {
+			ceiling = node
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+	return ceiling
+}
+
+// floorNode returns the largest node whose key is smaller than (or, if inclusive, equal to) the given key,
+// or nil if there is no such node.
+func (tree *Tree) floorNode(key interface{}, inclusive bool) *Node {
+	var floor *Node
+	node := tree.Root
+	for node != nil {
+		compare := tree.Comparator(key, node.Key)
+		if compare > 0 || compare == 0 && inclusive {
+			floor = node
+			node = node.Right
+		} else {
+			node = node.Left
+		}
+	}
+	return floor
+}
+
 // Clear removes all nodes from the tree.
 func (tree *Tree) Clear() {
 	tree.Root = nil
 	tree.size = 0
+	tree.modCount++
+}
+
+// Split moves the nodes with keys smaller than the key into the left tree and all other nodes into the right tree
+// in O(log n) time, leaving the tree empty.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Split(key interface{}) (left *Tree, right *Tree) {
+	leftRoot, _, node, rightRoot, rightHeight := tree.split(tree.Root, tree.Root.blackHeight(), key)
+	if node != nil {
+		rightRoot, _ = tree.join(nil, 0, node, rightRoot, rightHeight)
+	}
+	left, right = tree.from(leftRoot), tree.from(rightRoot)
+	tree.Clear()
+	return left, right
+}
+
+// Join moves the nodes of the left and the right tree, whose keys must all be smaller in the left tree than in the
+// right tree, into a new tree with the left tree's comparator in O(log n) time, leaving both trees empty.
+// Returns an error wrapping trees.ErrUnsorted if the keys are not in order, in which case the trees are not modified.
+func Join(left *Tree, right *Tree) (*Tree, error) {
+	if !left.Empty() && !right.Empty() {
+		if max, min := left.Right(), right.Left(); left.Comparator(max.Key, min.Key) >= 0 {
+			return nil, fmt.Errorf("%w: left key %v is not smaller than right key %v", trees.ErrUnsorted, max.Key, min.Key)
+		}
+	}
+	root, _ := left.join2(left.Root, left.Root.blackHeight(), right.Root, right.Root.blackHeight())
+	tree := left.from(root)
+	left.Clear()
+	right.Clear()
+	return tree, nil
+}
+
+// Union moves the nodes of both trees into a new tree with the first tree's comparator, leaving both trees empty.
+// The value in the first tree is kept for keys in both trees.
+// It takes O(m*log(n/m+1)) time, where m is the size of the smaller tree and n the size of the larger tree.
+// Both trees should have the same comparator.
+func Union(a *Tree, b *Tree) *Tree {
+	root, _ := a.union(a.Root, a.Root.blackHeight(), b.Root, b.Root.blackHeight())
+	tree := a.from(root)
+	a.Clear()
+	b.Clear()
+	return tree
+}
+
+// Intersection moves the nodes of the first tree whose keys are also in the second tree into a new tree with the
+// first tree's comparator, leaving both trees empty.
+// It takes O(m*log(n/m+1)) time, where m is the size of the smaller tree and n the size of the larger tree.
+// Both trees should have the same comparator.
+func Intersection(a *Tree, b *Tree) *Tree {
+	root, _ := a.intersection(a.Root, a.Root.blackHeight(), b.Root, b.Root.blackHeight())
+	tree := a.from(root)
+	a.Clear()
+	b.Clear()
+	return tree
 }
 
 // String returns a string representation of container
@@ -291,10 +500,155 @@ func (tree *Tree) String() string {
 	return str
 }
 
+// Validate checks that the root is black, that no red node has a red child, that every path from a node to its
+// leaves has the same number of black nodes, that the keys are in strictly increasing order, and that the parent
+// links and subtree sizes are consistent. Returns an error wrapping containers.ErrInvalid, which describes the first
+// violated rule and the node where it was violated, or nil if the tree is valid.
+func (tree *Tree) Validate() error {
+	if tree.Root == nil {
+		if tree.size != 0 {
+			return fmt.Errorf("%w: empty tree has size %d", containers.ErrInvalid, tree.size)
+		}
+		return nil
+	}
+	if tree.Root.Parent != nil {
+		return fmt.Errorf("%w: root %v has parent %v", containers.ErrInvalid, tree.Root, tree.Root.Parent)
+	}
+	if nodeColor(tree.Root) == red {
+		return fmt.Errorf("%w: root %v is red", containers.ErrInvalid, tree.Root)
+	}
+	var previous *Node
+	if _, err := tree.validate(tree.Root, &previous); err != nil {
+		return err
+	}
+	if tree.size != tree.Root.size {
+		return fmt.Errorf("%w: tree has size %d instead of %d", containers.ErrInvalid, tree.size, tree.Root.size)
+	}
+	return nil
+}
+
+// validate checks the subtree of the node, whose in-order predecessor is previous, and returns its black height,
+// see Validate. Sets previous to the last node of the subtree.
+func (tree *Tree) validate(node *Node, previous **Node) (blackHeight int, err error) {
+	if node == nil {
+		return 1, nil
+	}
+	if err := validateChildren(node); err != nil {
+		return 0, err
+	}
+	left, err := tree.validate(node.Left, previous)
+	if err != nil {
+		return 0, err
+	}
+	if *previous != nil && tree.Comparator((*previous).Key, node.Key) >= 0 {
+		return 0, fmt.Errorf("%w: node %v is not greater than its predecessor %v", containers.ErrInvalid, node, *previous)
+	}
+	*previous = node
+	right, err := tree.validate(node.Right, previous)
+	if err != nil {
+		return 0, err
+	}
+	if left != right {
+		return 0, fmt.Errorf("%w: node %v has black height %d on the left and %d on the right", containers.ErrInvalid, node, left, right)
+	}
+	if size := node.Left.Size() + node.Right.Size() + 1; node.size != size {
+		return 0, fmt.Errorf("%w: node %v has size %d instead of %d", containers.ErrInvalid, node, node.size, size)
+	}
+	if nodeColor(node) == black {
+		left++
+	}
+	return left, nil
+}
+
+// validateChildren checks that the children of the node link to it and that a red node has no red child.
+func validateChildren(node *Node) error {
+	for _, child := range []*Node{node.Left, node.Right} {
+		if child == nil {
+			continue
+		}
+		if child.Parent != node {
+			return fmt.Errorf("%w: node %v does not link to its parent %v", containers.ErrInvalid, child, node)
+		}
+		if nodeColor(node) == red && nodeColor(child) == red {
+			return fmt.Errorf("%w: red node %v has red child %v", containers.ErrInvalid, node, child)
+		}
+	}
+	return nil
+}
+
+// ToDOT returns the tree in the Graphviz DOT language, with the nodes filled in their colour.
+// The missing child of a node with a single child is drawn as an invisible node, which keeps the other child on its side.
+func (tree *Tree) ToDOT() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("digraph RedBlackTree {\n")
+	buffer.WriteString("\tgraph [ordering=out];\n")
+	buffer.WriteString("\tnode [style=filled, fontcolor=white];\n")
+	if !tree.Empty() {
+		id := 0
+		dot(&buffer, tree.Root, &id)
+	}
+	buffer.WriteString("}\n")
+	return buffer.String()
+}
+
+// ToMermaid returns the tree as a Mermaid flowchart, with the nodes filled in their colour.
+// The edge to the child of a node with a single child is labeled with the child's side.
+func (tree *Tree) ToMermaid() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("graph TD\n")
+	buffer.WriteString("\tclassDef black fill:#000,stroke:#000,color:#fff\n")
+	buffer.WriteString("\tclassDef red fill:#d00,stroke:#d00,color:#fff\n")
+	if !tree.Empty() {
+		id := 0
+		mermaid(&buffer, tree.Root, &id)
+	}
+	return buffer.String()
+}
+
 func (node *Node) String() string {
 	return fmt.Sprintf("%v", node.Key)
 }
 
+func dot(buffer *bytes.Buffer, node *Node, id *int) {
+	name := fmt.Sprintf("n%d", *id)
+	*id++
+	fill := "black"
+	if node.color == red {
+		fill = "red"
+	}
+	fmt.Fprintf(buffer, "\t%s [label=\"%s\", fillcolor=%s];\n", name, trees.EscapeDOT(node.String()), fill)
+	for _, child := range []*Node{node.Left, node.Right} {
+		if child != nil {
+			fmt.Fprintf(buffer, "\t%s -> n%d;\n", name, *id)
+			dot(buffer, child, id)
+		} else if node.Left != nil || node.Right != nil {
+			fmt.Fprintf(buffer, "\t%s -> n%d [style=invis];\n\tn%d [style=invis];\n", name, *id, *id)
+			*id++
+		}
+	}
+}
+
+func mermaid(buffer *bytes.Buffer, node *Node, id *int) {
+	name := fmt.Sprintf("n%d", *id)
+	*id++
+	class := "black"
+	if node.color == red {
+		class = "red"
+	}
+	fmt.Fprintf(buffer, "\t%s[\"%s\"]:::%s\n", name, trees.EscapeMermaid(node.String()), class)
+	for side, child := range []*Node{node.Left, node.Right} {
+		if child == nil {
+			continue
+		}
+		edge := "-->"
+		if node.Left == nil || node.Right == nil {
+			edge = []string{"-->|left|", "-->|right|"}[side]
+		}
+		fmt.Fprintf(buffer, "\t%s %s n%d\n", name, edge, *id)
+		mermaid(buffer, child, id)
+	}
+}
+
 func output(node *Node, prefix string, isTail bool, str *string) {
 	if node.Right != nil {
 		newPrefix := prefix
@@ -323,6 +677,251 @@ func output(node *Node, prefix string, isTail bool, str *string) {
 	}
 }
 
+// build returns a perfectly balanced subtree of the sorted keys and values, whose deepest nodes are red,
+// unless they are the root, and all other nodes are black, so that all paths have the same number of black nodes.
+func (tree *Tree) build(keys []interface{}, values []interface{}, parent *Node, depth int, deepest int) *Node {
+	if len(keys) == 0 {
+		return nil
+	}
+	middle := len(keys) / 2
+	node := &Node{Key: keys[middle], Value: values[middle], color: black, size: len(keys), Parent: parent}
+	if depth == deepest && depth > 0 {
+		node.color = red
+	}
+	node.Left = tree.build(keys[:middle], values[:middle], node, depth+1, deepest)
+	node.Right = tree.build(keys[middle+1:], values[middle+1:], node, depth+1, deepest)
+	tree.augment(node)
+	return node
+}
+
+// from returns a tree with the root and the tree's comparator and augment function.
+func (tree *Tree) from(root *Node) *Tree {
+	if root != nil {
+		root.color = black
+	}
+	return &Tree{Root: root, size: root.Size(), Comparator: tree.Comparator, Augment: tree.Augment}
+}
+
+// blackHeight returns the number of black nodes on each path from the node down to a leaf.
+func (node *Node) blackHeight() int {
+	height := 0
+	for ; node != nil; node = node.Left {
+		if node.color == black {
+			height++
+		}
+	}
+	return height
+}
+
+// detach removes the link from the node to its parent and returns the node.
+func (node *Node) detach() *Node {
+	if node != nil {
+		node.Parent = nil
+	}
+	return node
+}
+
+// The functions below implement the join-based algorithms on subtrees, whose black heights are passed along,
+// so that joining takes time proportional to the difference of the black heights, and splitting telescopes to
+// O(log n). The subtrees are detached from their parents and their roots may be red.
+// Reference: https://en.wikipedia.org/wiki/Join-based_tree_algorithms
+
+// join returns the root of a subtree of the left subtree, the node and the right subtree, whose keys are in order,
+// and its black height.
+func (tree *Tree) join(left *Node, leftHeight int, node *Node, right *Node, rightHeight int) (*Node, int) {
+	if nodeColor(left) == red {
+		left.color = black
+		leftHeight++
+	}
+	if nodeColor(right) == red {
+		right.color = black
+		rightHeight++
+	}
+	node.Parent = nil
+	if leftHeight == rightHeight {
+		node.Left, node.Right, node.color = left, right, black
+		node.link()
+		tree.augment(node)
+		return node, leftHeight + 1
+	}
+
+	// Replace the black node of the shorter subtree's black height on the inner spine of the taller subtree by the
+	// red node with the replaced node and the shorter subtree as children, then fix red parents as after an insertion.
+	joined := &Tree{Augment: tree.Augment}
+	height := leftHeight
+	var parent *Node
+	if leftHeight > rightHeight {
+		joined.Root = left
+		child := left
+		for nodeColor(child) == red || height != rightHeight {
+			if child.color == black {
+				height--
+			}
+			parent, child = child, child.Right
+		}
+		node.Left, node.Right = child, right
+		parent.Right = node
+		height = leftHeight
+	} else {
+		joined.Root = right
+		child := right
+		height = rightHeight
+		for nodeColor(child) == red || height != leftHeight {
+			if child.color == black {
+				height--
+			}
+			parent, child = child, child.Left
+		}
+		node.Left, node.Right = left, child
+		parent.Left = node
+		height = rightHeight
+	}
+	node.Parent = parent
+	return joined.rebalanceJoined(node, height)
+}
+
+// rebalanceJoined colors the node, which was linked into the tree by join, red, updates its ancestors and fixes red
+// parents as after an insertion. Returns the root of the tree and its black height, which was height before.
+func (tree *Tree) rebalanceJoined(node *Node, height int) (*Node, int) {
+	node.color = red
+	node.link()
+	tree.augment(node)
+	for parent := node.Parent; parent != nil; parent = parent.Parent {
+		parent.updateSize()
+		tree.augment(parent)
+	}
+	for node.Parent != nil && node.Parent.color == red {
+		if uncle := node.uncle(); nodeColor(uncle) == red {
+			node.Parent.color = black
+			uncle.color = black
+			node = node.grandparent()
+			node.color = red
+		} else {
+			tree.insertCase4(node)
+			break
+		}
+	}
+	if tree.Root.color == red {
+		tree.Root.color = black
+		height++
+	}
+	return tree.Root, height
+}
+
+// link sets the node as the parent of its children and updates its size.
+func (node *Node) link() {
+	if node.Left != nil {
+		node.Left.Parent = node
+	}
+	if node.Right != nil {
+		node.Right.Parent = node
+	}
+	node.updateSize()
+}
+
+// join2 returns the root of a subtree of the left and the right subtree, whose keys are in order, and its black height.
+func (tree *Tree) join2(left *Node, leftHeight int, right *Node, rightHeight int) (*Node, int) {
+	if left == nil {
+		return right, rightHeight
+	}
+	left, leftHeight, last := tree.splitLast(left, leftHeight)
+	return tree.join(left, leftHeight, last, right, rightHeight)
+}
+
+// split returns the subtrees of the keys smaller and greater than the key with their black heights and the node of
+// the key, if any, of the subtree.
+func (tree *Tree) split(node *Node, height int, key interface{}) (left *Node, leftHeight int, found *Node, right *Node, rightHeight int) {
+	if node == nil {
+		return nil, 0, nil, nil, 0
+	}
+	childHeight := height
+	if node.color == black {
+		childHeight--
+	}
+	nodeLeft, nodeRight := node.Left.detach(), node.Right.detach()
+	compare := tree.Comparator(key, node.Key)
+	switch {
+	case compare < 0:
+		left, leftHeight, found, right, rightHeight = tree.split(nodeLeft, childHeight, key)
+		right, rightHeight = tree.join(right, rightHeight, node, nodeRight, childHeight)
+		return left, leftHeight, found, right, rightHeight
+	case compare > 0:
+		left, leftHeight, found, right, rightHeight = tree.split(nodeRight, childHeight, key)
+		left, leftHeight = tree.join(nodeLeft, childHeight, node, left, leftHeight)
+		return left, leftHeight, found, right, rightHeight
+	}
+	return nodeLeft, childHeight, node, nodeRight, childHeight
+}
+
+// splitLast returns the subtree without its last node with its black height and the last node.
+func (tree *Tree) splitLast(node *Node, height int) (rest *Node, restHeight int, last *Node) {
+	childHeight := height
+	if node.color == black {
+		childHeight--
+	}
+	if node.Right == nil {
+		return node.Left.detach(), childHeight, node
+	}
+	rest, restHeight, last = tree.splitLast(node.Right.detach(), childHeight)
+	rest, restHeight = tree.join(node.Left.detach(), childHeight, node, rest, restHeight)
+	return rest, restHeight, last
+}
+
+// union returns the root of a subtree of the union of the subtrees and its black height.
+func (tree *Tree) union(a *Node, aHeight int, b *Node, bHeight int) (*Node, int) {
+	if a == nil {
+		return b, bHeight
+	}
+	if b == nil {
+		return a, aHeight
+	}
+	childHeight := aHeight
+	if a.color == black {
+		childHeight--
+	}
+	bLeft, bLeftHeight, _, bRight, bRightHeight := tree.split(b, bHeight, a.Key)
+	left, leftHeight := tree.union(a.Left.detach(), childHeight, bLeft, bLeftHeight)
+	right, rightHeight := tree.union(a.Right.detach(), childHeight, bRight, bRightHeight)
+	return tree.join(left, leftHeight, a, right, rightHeight)
+}
+
+// intersection returns the root of a subtree of the nodes of the subtree a whose keys are in the subtree b and
+// its black height.
+func (tree *Tree) intersection(a *Node, aHeight int, b *Node, bHeight int) (*Node, int) {
+	if a == nil || b == nil {
+		return nil, 0
+	}
+	childHeight := aHeight
+	if a.color == black {
+		childHeight--
+	}
+	bLeft, bLeftHeight, found, bRight, bRightHeight := tree.split(b, bHeight, a.Key)
+	left, leftHeight := tree.intersection(a.Left.detach(), childHeight, bLeft, bLeftHeight)
+	right, rightHeight := tree.intersection(a.Right.detach(), childHeight, bRight, bRightHeight)
+	if found == nil {
+		return tree.join2(left, leftHeight, right, rightHeight)
+	}
+	return tree.join(left, leftHeight, a, right, rightHeight)
+}
+
+// rank returns the number of keys smaller than the given key and whether the key is in the tree.
+func (tree *Tree) rank(key interface{}) (rank int, found bool) {
+	node := tree.Root
+	for node != nil {
+		compare := tree.Comparator(key, node.Key)
+		switch {
+		case compare == 0:
+			return rank + node.Left.Size(), true
+		case compare < 0:
+			node = node.Left
+		case compare > 0:
+			rank += node.Left.Size() + 1
+			node = node.Right
+		}
+	}
+	return rank, false
+}
+
 func (tree *Tree) lookup(key interface{}) *Node {
 	node := tree.Root
 	for node != nil {
@@ -372,6 +971,10 @@ func (tree *Tree) rotateLeft(node *Node) {
 	}
 	right.Left = node
 	node.Parent = right
+	right.size = node.size
+	node.updateSize()
+	tree.augment(node)
+	tree.augment(right)
 }
 
 func (tree *Tree) rotateRight(node *Node) {
@@ -383,6 +986,31 @@ func (tree *Tree) rotateRight(node *Node) {
 	}
 	left.Right = node
 	node.Parent = left
+	left.size = node.size
+	node.updateSize()
+	tree.augment(node)
+	tree.augment(left)
+}
+
+// updateSize recomputes the size of the node from the sizes of its children.
+func (node *Node) updateSize() {
+	node.size = 1 + node.Left.Size() + node.Right.Size()
+}
+
+// augment calls the Augment function, if any, for the node.
+func (tree *Tree) augment(node *Node) {
+	if tree.Augment != nil {
+		tree.Augment(node)
+	}
+}
+
+// augmentPath calls the Augment function, if any, for the node and its ancestors, bottom-up.
+func (tree *Tree) augmentPath(node *Node) {
+	if tree.Augment != nil {
+		for ; node != nil; node = node.Parent {
+			tree.Augment(node)
+		}
+	}
 }
 
 func (tree *Tree) replaceNode(old *Node, new *Node) {
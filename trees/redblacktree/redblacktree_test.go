@@ -6,8 +6,12 @@ package redblacktree
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/uncle-gua/gods/containers"
+	"github.com/uncle-gua/gods/trees"
 	"github.com/uncle-gua/gods/utils"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -233,6 +237,367 @@ func TestRedBlackTreeCeilingAndFloor(t *testing.T) {
 	}
 }
 
+func TestRedBlackTreeOrderStatistics(t *testing.T) {
+	tree := NewWithIntComparator()
+	for _, key := range []int{12, 4, 20, 8, 16, 2, 10, 18, 6, 14} {
+		tree.Put(key, key)
+	}
+	tree.Remove(10)
+
+	tests1 := [][]interface{}{
+		{1, 0, -1},
+		{2, 0, 0},
+		{3, 1, -1},
+		{10, 4, -1},
+		{12, 4, 4},
+		{20, 8, 8},
+		{21, 9, -1},
+	}
+	for _, test := range tests1 {
+		if actualValue, expectedValue := tree.Rank(test[0]), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := tree.IndexOf(test[0]), test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	tests2 := [][]interface{}{
+		{-1, nil, false},
+		{0, 2, true},
+		{4, 12, true},
+		{8, 20, true},
+		{9, nil, false},
+	}
+	for _, test := range tests2 {
+		node, found := tree.GetAt(test[0].(int))
+		var key interface{}
+		if found {
+			key = node.Key
+		}
+		if actualValue, expectedValue := found, test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := key, test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	tests3 := [][]interface{}{
+		{0, 100, 9},
+		{4, 14, 4},
+		{13, 15, 1},
+		{5, 5, 0},
+		{14, 4, 0},
+	}
+	for _, test := range tests3 {
+		if actualValue, expectedValue := tree.CountRange(test[0], test[1]), test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestRedBlackTreeOrderStatisticsConsistency(t *testing.T) {
+	tree := NewWithIntComparator()
+	for key := 0; key < 1000; key++ {
+		tree.Put(key, key)
+	}
+	for key := 0; key < 1000; key += 3 {
+		tree.Remove(key)
+	}
+	for index := 0; index < 666; index++ {
+		node, found := tree.GetAt(index)
+		var key interface{}
+		if found {
+			key = node.Key
+		}
+		if !found || tree.IndexOf(key) != index || tree.Rank(key) != index {
+			t.Errorf("Got %v expected %v", tree.IndexOf(key), index)
+		}
+	}
+}
+
+func TestRedBlackTreeLoadSorted(t *testing.T) {
+	for n := 0; n < 100; n++ {
+		keys, values := make([]interface{}, n), make([]interface{}, n)
+		for i := range keys {
+			keys[i], values[i] = i*2, fmt.Sprintf("v%d", i)
+		}
+		tree, err := NewFromSorted(utils.IntComparator, keys, values)
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+		assertRedBlackTree(t, tree)
+		if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), fmt.Sprintf("%v", keys); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", tree.Values()), fmt.Sprintf("%v", values); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		for i := 0; i < n; i++ {
+			tree.Put(i*2+1, i)
+			tree.Remove(i * 2)
+		}
+		assertRedBlackTree(t, tree)
+		if actualValue, expectedValue := tree.Size(), n; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	tree := NewWithIntComparator()
+	tree.Put(1, "a")
+	tests := [][]interface{}{
+		{[]interface{}{1, 3, 2}, []interface{}{"a", "b", "c"}, true},
+		{[]interface{}{1, 2, 2}, []interface{}{"a", "b", "c"}, true},
+		{[]interface{}{1, 2}, []interface{}{"a"}, false},
+	}
+	for _, test := range tests {
+		err := tree.LoadSorted(test[0].([]interface{}), test[1].([]interface{}))
+		if err == nil {
+			t.Errorf("Got no error for %v", test[0])
+		}
+		if actualValue, expectedValue := errors.Is(err, trees.ErrUnsorted), test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), "[1]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if _, err := NewFromSorted(utils.IntComparator, []interface{}{2, 1}, []interface{}{"b", "a"}); !errors.Is(err, trees.ErrUnsorted) {
+		t.Errorf("Got %v expected %v", err, trees.ErrUnsorted)
+	}
+
+	source := NewWithStringComparator()
+	source.Put("c", 3)
+	source.Put("a", 1)
+	source.Put("b", 2)
+	it := source.Iterator()
+	it.Next()
+	tree, err := NewFromIterator(utils.StringComparator, &it)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	assertRedBlackTree(t, tree)
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), "[b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestRedBlackTreeSplitAndJoin(t *testing.T) {
+	for n := 0; n < 40; n++ {
+		for key := -1; key <= n; key++ {
+			assertRedBlackTreeSplitAndJoin(t, n, key)
+		}
+	}
+}
+
+// assertRedBlackTreeSplitAndJoin splits a tree with the keys 0 to n-1 at the key and joins the parts again.
+func assertRedBlackTreeSplitAndJoin(t *testing.T, n int, key int) {
+	t.Helper()
+	tree := NewWithIntComparator()
+	for i := 0; i < n; i++ {
+		tree.Put(i, i)
+	}
+	left, right := tree.Split(key)
+	assertRedBlackTree(t, left)
+	assertRedBlackTree(t, right)
+	if actualValue, expectedValue := left.Size(), min(max(key, 0), n); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := right.Size(), n-left.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := tree.Empty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if n > 0 {
+		if node := left.Right(); node != nil && node.Key.(int) >= key {
+			t.Errorf("Got %v expected smaller than %v", node.Key, key)
+		}
+		if node := right.Left(); node != nil && node.Key.(int) < key {
+			t.Errorf("Got %v expected at least %v", node.Key, key)
+		}
+	}
+	tree, err := Join(left, right)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	assertRedBlackTree(t, tree)
+	if actualValue, expectedValue := tree.Size(), n; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := left.Empty() && right.Empty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := tree.IndexOf(n-1), n-1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestRedBlackTreeJoin(t *testing.T) {
+	left, right := NewWithIntComparator(), NewWithIntComparator()
+	for i := 0; i < 1000; i++ {
+		left.Put(i, i)
+	}
+	right.Put(1000, 1000)
+	tree, err := Join(left, right)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	assertRedBlackTree(t, tree)
+	left, right = tree.Split(1)
+	tree, err = Join(left, right)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	assertRedBlackTree(t, tree)
+	if actualValue, expectedValue := tree.Size(), 1001; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	left, right = NewWithIntComparator(), NewWithIntComparator()
+	left.Put(1, "a")
+	left.Put(5, "e")
+	right.Put(3, "c")
+	if _, err := Join(left, right); !errors.Is(err, trees.ErrUnsorted) {
+		t.Errorf("Got %v expected %v", err, trees.ErrUnsorted)
+	}
+	if actualValue, expectedValue := left.Size()+right.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestRedBlackTreeUnionAndIntersection(t *testing.T) {
+	a, b := NewWithIntComparator(), NewWithIntComparator()
+	a.Put(1, "a")
+	a.Put(2, "b")
+	a.Put(3, "c")
+	a.Put(5, "e")
+	b.Put(2, "x")
+	b.Put(4, "y")
+	b.Put(5, "z")
+	b.Put(6, "w")
+	c, d := NewWithIntComparator(), NewWithIntComparator()
+	for it := a.Iterator(); it.Next(); {
+		c.Put(it.Key(), it.Value())
+	}
+	for it := b.Iterator(); it.Next(); {
+		d.Put(it.Key(), it.Value())
+	}
+
+	union := Union(a, b)
+	assertRedBlackTree(t, union)
+	if actualValue, expectedValue := fmt.Sprintf("%v", union.Keys()), "[1 2 3 4 5 6]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", union.Values()), "[a b c y e w]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := a.Empty() && b.Empty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	intersection := Intersection(c, d)
+	assertRedBlackTree(t, intersection)
+	if actualValue, expectedValue := fmt.Sprintf("%v", intersection.Keys()), "[2 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", intersection.Values()), "[b e]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+}
+
+func TestRedBlackTreeUnionAndIntersectionOfMultiples(t *testing.T) {
+	for _, n := range []int{0, 1, 10, 100, 1000} {
+		for _, m := range []int{0, 1, 10, 100, 1000} {
+			union := Union(newMultiplesTree(2, n), newMultiplesTree(3, m))
+			assertRedBlackTree(t, union)
+			intersection := Intersection(newMultiplesTree(2, n), newMultiplesTree(3, m))
+			assertRedBlackTree(t, intersection)
+			expectedUnion, expectedIntersection := countMultiples(n, m)
+			if actualValue, expectedValue := union.Size(), expectedUnion; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			if actualValue, expectedValue := intersection.Size(), expectedIntersection; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			if !slices.IsSortedFunc(union.Keys(), utils.IntComparator) {
+				t.Errorf("Got unsorted keys %v", union.Keys())
+			}
+			for _, key := range intersection.Keys() {
+				if key.(int)%6 != 0 {
+					t.Errorf("Got %v expected a multiple of %v", key, 6)
+				}
+			}
+		}
+	}
+}
+
+// newMultiplesTree returns a tree with the n keys 0, k, 2k, ...
+func newMultiplesTree(k int, n int) *Tree {
+	tree := NewWithIntComparator()
+	tree.Augment = func(node *Node) {
+		node.Value = node.size // the subtree's size, checked by assertRedBlackTree
+	}
+	for i := 0; i < n; i++ {
+		tree.Put(i*k, i)
+	}
+	return tree
+}
+
+// countMultiples returns the number of keys in the union and the intersection of the n multiples of 2 and
+// the m multiples of 3 starting at 0.
+func countMultiples(n int, m int) (union int, intersection int) {
+	for i := 0; i < max(2*n, 3*m); i++ {
+		if i%2 == 0 && i < 2*n || i%3 == 0 && i < 3*m {
+			union++
+		}
+		if i%2 == 0 && i < 2*n && i%3 == 0 && i < 3*m {
+			intersection++
+		}
+	}
+	return union, intersection
+}
+
+// assertRedBlackTree checks the red-black properties, the parent links and the subtree sizes of the tree.
+func assertRedBlackTree(t *testing.T, tree *Tree) {
+	t.Helper()
+	if tree.Root != nil && tree.Root.color != black {
+		t.Errorf("Got red root")
+	}
+	var check func(node *Node, parent *Node) (blackHeight int, size int)
+	check = func(node *Node, parent *Node) (int, int) {
+		if node == nil {
+			return 1, 0
+		}
+		if node.Parent != parent {
+			t.Errorf("Got parent %v expected %v", node.Parent, parent)
+		}
+		if node.color == red && (nodeColor(node.Left) == red || nodeColor(node.Right) == red) {
+			t.Errorf("Got red node %v with a red child", node)
+		}
+		leftHeight, leftSize := check(node.Left, node)
+		rightHeight, rightSize := check(node.Right, node)
+		if leftHeight != rightHeight {
+			t.Errorf("Got black heights %v and %v below %v", leftHeight, rightHeight, node)
+		}
+		if node.size != leftSize+rightSize+1 {
+			t.Errorf("Got size %v expected %v", node.size, leftSize+rightSize+1)
+		}
+		if size, augmented := node.Value.(int); tree.Augment != nil && augmented && size != node.size {
+			t.Errorf("Got augmented size %v expected %v", size, node.size)
+		}
+		if node.color == black {
+			leftHeight++
+		}
+		return leftHeight, leftSize + rightSize + 1
+	}
+	if _, size := check(tree.Root, nil); size != tree.Size() {
+		t.Errorf("Got %v expected %v", size, tree.Size())
+	}
+}
+
 func TestRedBlackTreeIteratorNextOnEmpty(t *testing.T) {
 	tree := NewWithIntComparator()
 	it := tree.Iterator()
@@ -695,6 +1060,33 @@ func TestRedBlackTreeSerialization(t *testing.T) {
 	if err != nil {
 		t.Errorf("Got error %v", err)
 	}
+
+	err = tree.FromJSON([]byte(`{"c":3,"a":1,"b":2,"d":4}`))
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	assertRedBlackTree(t, tree)
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), "[a b c d]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestRedBlackTreeSerializationEqualKeys(t *testing.T) {
+	tree := NewWith(utils.CaseInsensitiveStringComparator)
+	tree.Put("x", 0)
+	err := tree.FromJSON([]byte(`{"Apple":1,"apple":2,"banana":3}`))
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := tree.Keys(), []interface{}{"apple", "banana"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := tree.Values(), []interface{}{2.0, 3.0}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := tree.Get("APPLE"); actualValue != 2.0 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 2.0)
+	}
 }
 
 func TestRedBlackTreeString(t *testing.T) {
@@ -705,6 +1097,338 @@ func TestRedBlackTreeString(t *testing.T) {
 	}
 }
 
+func TestRedBlackTreeIteratorConcurrentModification(t *testing.T) {
+	tree := NewWithStringComparator()
+	tree.Put("a", 1)
+	tree.Put("b", 2)
+	tree.Put("c", 3)
+
+	// modifications before the first element are allowed
+	it := tree.Iterator()
+	tree.Put("d", 4)
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, tree.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// modifications after Begin() or End() are allowed, moving in either direction accepts them
+	it.End()
+	tree.Put("f", 6)
+	if it.Next() {
+		t.Errorf("Should not iterate past the last element")
+	}
+	if !it.Prev() || it.Key() != "f" {
+		t.Errorf("Got %v expected %v", it.Key(), "f")
+	}
+	it.Begin()
+	tree.Remove("f")
+	if it.Prev() {
+		t.Errorf("Should not iterate before the first element")
+	}
+	if !it.Next() || it.Key() != "a" {
+		t.Errorf("Got %v expected %v", it.Key(), "a")
+	}
+
+	// modifications during iteration panic
+	func() {
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		it := tree.Iterator()
+		it.Next()
+		tree.Remove("a")
+		it.Next()
+	}()
+
+	// checked iterator stops and reports the error
+	checked := tree.CheckedIterator()
+	checked.Next()
+	tree.Put("e", 5)
+	if checked.Next() {
+		t.Errorf("Should not iterate after modification")
+	}
+	if actualValue, expectedValue := checked.Err(), containers.ErrConcurrentModification; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checked.Begin()
+	if actualValue := checked.Err(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	count = 0
+	for checked.Next() {
+		count++
+	}
+	if actualValue, expectedValue := count, tree.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestRedBlackTreeIteratorModifiedAtBoundaries(t *testing.T) {
+	tree := NewWithStringComparator()
+	tree.Put("b", 0)
+	tree.Put("c", 0)
+	it := tree.Iterator()
+	it.Next()
+
+	// moving from Begin() or End() after a modification starts over on the modified container
+	tests := [][]interface{}{
+		{true, true, "a", "a"},
+		{false, true, "d", "d"},
+		{true, false, "a", "b"},
+		{false, false, "d", "c"},
+	}
+	for _, test := range tests {
+		forward, put, key, expectedValue := test[0].(bool), test[1].(bool), test[2], test[3]
+		if forward {
+			it.Begin()
+		} else {
+			it.End()
+		}
+		if put {
+			tree.Put(key, 0)
+		} else {
+			tree.Remove(key)
+		}
+		moved := false
+		if forward {
+			moved = it.Next()
+		} else {
+			moved = it.Prev()
+		}
+		if !moved {
+			t.Errorf("Got %v expected %v", moved, true)
+			continue
+		}
+		if actualValue := it.Key(); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestRedBlackTreeIteratorClone(t *testing.T) {
+	tree := NewWithStringComparator()
+	tree.Put("a", 1)
+	tree.Put("b", 2)
+	tree.Put("c", 3)
+
+	it := tree.Iterator()
+	it.Next()
+	clone := it.Clone()
+	first := it.Key()
+	it.Next()
+	if actualValue, expectedValue := clone.Key(), first; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !clone.Next() || clone.Key() != it.Key() {
+		t.Errorf("Got %v expected %v", clone.Key(), it.Key())
+	}
+	clone.Next()
+	if clone.Next() {
+		t.Errorf("Should not go past the last element")
+	}
+	if !it.Next() {
+		t.Errorf("Should move independently of the clone")
+	}
+}
+
+func TestRedBlackTreeIteratorRemove(t *testing.T) {
+	tree := NewWithIntComparator()
+	for i := 1; i <= 100; i++ {
+		tree.Put(i, i)
+	}
+	it := tree.Iterator()
+	if it.Remove() {
+		t.Errorf("Should not remove before the first element")
+	}
+	for it.Next() {
+		if it.Key().(int)%3 == 0 && !it.Remove() {
+			t.Errorf("Should remove %v", it.Key())
+		}
+	}
+	for it.Prev() {
+		if it.Key().(int)%5 == 0 && !it.Remove() {
+			t.Errorf("Should remove %v", it.Key())
+		}
+	}
+	expected := []interface{}{}
+	for i := 1; i <= 100; i++ {
+		if i%3 != 0 && i%5 != 0 {
+			expected = append(expected, i)
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), fmt.Sprintf("%v", expected); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := tree.Size(), len(expected); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+}
+
+func TestRedBlackTreeIteratorRemoveNeighbours(t *testing.T) {
+	tree := NewWithIntComparator()
+	for i := 1; i <= 100; i++ {
+		if i%3 != 0 && i%5 != 0 {
+			tree.Put(i, i)
+		}
+	}
+	it := tree.Iterator()
+
+	// the iterator is left between the neighbours of the removed element
+	it.First()
+	it.Next()
+	it.Remove()
+	if !it.Prev() || it.Key() != 1 {
+		t.Errorf("Got %v expected %v", it.Key(), 1)
+	}
+	if !it.Next() || it.Key() != 4 {
+		t.Errorf("Got %v expected %v", it.Key(), 4)
+	}
+	it.Last()
+	it.Remove()
+	if it.Next() {
+		t.Errorf("Should not move past the last element")
+	}
+	if !it.Prev() || it.Key() != 97 {
+		t.Errorf("Got %v expected %v", it.Key(), 97)
+	}
+}
+
+func TestRedBlackTreeIteratorSeek(t *testing.T) {
+	tree := NewWithIntComparator()
+	for i := 2; i <= 20; i += 2 {
+		tree.Put(i, i)
+	}
+	it := tree.Iterator()
+	tests := [][]interface{}{
+		{it.SeekGE, 4, true, 4},
+		{it.SeekGE, 5, true, 6},
+		{it.SeekGE, 0, true, 2},
+		{it.SeekGE, 21, false, nil},
+		{it.SeekGT, 4, true, 6},
+		{it.SeekGT, 20, false, nil},
+		{it.SeekLE, 4, true, 4},
+		{it.SeekLE, 5, true, 4},
+		{it.SeekLE, 1, false, nil},
+		{it.SeekLT, 4, true, 2},
+		{it.SeekLT, 100, true, 20},
+		{it.SeekLT, 2, false, nil},
+	}
+	for _, test := range tests {
+		found := test[0].(func(interface{}) bool)(test[1])
+		if actualValue, expectedValue := found, test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if found && it.Key() != test[3] {
+			t.Errorf("Got %v expected %v", it.Key(), test[3])
+		}
+	}
+
+	// iteration continues from the sought element in both directions
+	it.SeekGE(5)
+	if !it.Next() || it.Key() != 8 {
+		t.Errorf("Got %v expected %v", it.Key(), 8)
+	}
+	it.SeekGE(5)
+	if !it.Prev() || it.Key() != 4 {
+		t.Errorf("Got %v expected %v", it.Key(), 4)
+	}
+	it.SeekGT(20)
+	if !it.Prev() || it.Key() != 20 {
+		t.Errorf("Got %v expected %v", it.Key(), 20)
+	}
+	it.SeekLT(2)
+	if !it.Next() || it.Key() != 2 {
+		t.Errorf("Got %v expected %v", it.Key(), 2)
+	}
+}
+
+func TestRedBlackTreeRangeIterator(t *testing.T) {
+	tree := NewWithIntComparator()
+	for i := 2; i <= 20; i += 2 {
+		tree.Put(i, i)
+	}
+	it := tree.RangeIterator(5, 15)
+	keys := []interface{}{}
+	for it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[6 8 10 12 14]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	keys = []interface{}{}
+	for it.Prev() {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[14 12 10 8 6]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tests := [][]interface{}{
+		{it.First, 6},
+		{it.Last, 14},
+		{func() bool { return it.SeekGE(1) }, 6},
+		{func() bool { return it.SeekGT(10) }, 12},
+		{func() bool { return it.SeekLE(100) }, 14},
+		{func() bool { return it.SeekLT(6) }, nil},
+		{func() bool { return it.SeekGE(15) }, nil},
+	}
+	for _, test := range tests {
+		found := test[0].(func() bool)()
+		if actualValue, expectedValue := found, test[1] != nil; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if found && it.Key() != test[1] {
+			t.Errorf("Got %v expected %v", it.Key(), test[1])
+		}
+	}
+}
+
+func TestRedBlackTreeSeq(t *testing.T) {
+	tree := NewWithStringComparator()
+	tree.Put("c", 3)
+	tree.Put("a", 1)
+	tree.Put("b", 2)
+
+	pairs := []string{}
+	for key, value := range tree.AllSeq() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "a:1 b:2 c:3"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(tree.KeysSeq())), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", slices.Collect(tree.ValuesSeq())), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	pairs = []string{}
+	for key, value := range tree.Backward() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "c:3 b:2 a:1"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	count := 0
+	for key := range tree.KeysSeq() {
+		if key == "b" {
+			break
+		}
+		count++
+	}
+	if actualValue, expectedValue := count, 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func benchmarkGet(b *testing.B, tree *Tree, size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -857,3 +1581,99 @@ func BenchmarkRedBlackTreeRemove100000(b *testing.B) {
 	b.StartTimer()
 	benchmarkRemove(b, tree, size)
 }
+
+func TestRedBlackTreeValidate(t *testing.T) {
+	tests := [][]interface{}{
+		{func(tree *Tree) {}, ""},
+		{func(tree *Tree) { tree.Root.color = red }, "root 4 is red"},
+		{func(tree *Tree) { tree.Root.Left.Parent = nil }, "node 2 does not link to its parent 4"},
+		{func(tree *Tree) { tree.Root.Right.color = red }, "red node 6 has red child 5"},
+		{func(tree *Tree) { tree.Root.Left.Left.Key = 3 }, "node 2 is not greater than its predecessor 3"},
+		{func(tree *Tree) { tree.Root.Left.Left.color = black }, "node 2 has black height 2 on the left and 1 on the right"},
+		{func(tree *Tree) { tree.Root.size = 6 }, "node 4 has size 6 instead of 7"},
+		{func(tree *Tree) { tree.size = 8 }, "tree has size 8 instead of 7"},
+	}
+	for _, test := range tests {
+		tree := NewWithIntComparator()
+		for _, key := range []int{4, 2, 6, 1, 3, 5, 7} {
+			tree.Put(key, key)
+		}
+		test[0].(func(*Tree))(tree)
+		err := tree.Validate()
+		if test[1] == "" {
+			if err != nil {
+				t.Errorf("Got %v expected %v", err, nil)
+			}
+			continue
+		}
+		if !errors.Is(err, containers.ErrInvalid) {
+			t.Errorf("Got %v expected %v", err, containers.ErrInvalid)
+		}
+		if err != nil && !strings.HasSuffix(err.Error(), test[1].(string)) {
+			t.Errorf("Got %v expected %v", err, test[1])
+		}
+	}
+}
+
+func TestRedBlackTreeToDOT(t *testing.T) {
+	tree := NewWithStringComparator()
+	if actualValue, expectedValue := tree.ToDOT(), "digraph RedBlackTree {\n\tgraph [ordering=out];\n\tnode [style=filled, fontcolor=white];\n}\n"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tree.Put("b", 2)
+	tree.Put("a", 1)
+	tree.Put(`"c"`, 3)
+	expectedValue := "digraph RedBlackTree {\n" +
+		"\tgraph [ordering=out];\n" +
+		"\tnode [style=filled, fontcolor=white];\n" +
+		"\tn0 [label=\"a\", fillcolor=black];\n" +
+		"\tn0 -> n1;\n" +
+		"\tn1 [label=\"\\\"c\\\"\", fillcolor=red];\n" +
+		"\tn0 -> n2;\n" +
+		"\tn2 [label=\"b\", fillcolor=red];\n" +
+		"}\n"
+	if actualValue := tree.ToDOT(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tree.Remove(`"c"`)
+	expectedValue = "digraph RedBlackTree {\n" +
+		"\tgraph [ordering=out];\n" +
+		"\tnode [style=filled, fontcolor=white];\n" +
+		"\tn0 [label=\"a\", fillcolor=black];\n" +
+		"\tn0 -> n1 [style=invis];\n" +
+		"\tn1 [style=invis];\n" +
+		"\tn0 -> n2;\n" +
+		"\tn2 [label=\"b\", fillcolor=red];\n" +
+		"}\n"
+	if actualValue := tree.ToDOT(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestRedBlackTreeToMermaid(t *testing.T) {
+	tree := NewWithStringComparator()
+	header := "graph TD\n\tclassDef black fill:#000,stroke:#000,color:#fff\n\tclassDef red fill:#d00,stroke:#d00,color:#fff\n"
+	if actualValue, expectedValue := tree.ToMermaid(), header; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tree.Put("b", 2)
+	tree.Put("a", 1)
+	tree.Put(`"c"`, 3)
+	expectedValue := header +
+		"\tn0[\"a\"]:::black\n" +
+		"\tn0 --> n1\n" +
+		"\tn1[\"#quot;c#quot;\"]:::red\n" +
+		"\tn0 --> n2\n" +
+		"\tn2[\"b\"]:::red\n"
+	if actualValue := tree.ToMermaid(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tree.Remove(`"c"`)
+	expectedValue = header +
+		"\tn0[\"a\"]:::black\n" +
+		"\tn0 -->|right| n1\n" +
+		"\tn1[\"b\"]:::red\n"
+	if actualValue := tree.ToMermaid(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
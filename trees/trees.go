@@ -2,14 +2,21 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// Package trees provides an abstract Tree interface.
+// Package trees provides an abstract Tree interface and helpers shared by the tree implementations.
 //
 // In computer science, a tree is a widely used abstract data type (ADT) or data structure implementing this ADT that simulates a hierarchical tree structure, with a root value and subtrees of children with a parent node, represented as a set of linked nodes.
 //
 // Reference: https://en.wikipedia.org/wiki/Tree_%28data_structure%29
 package trees
 
-import "github.com/uncle-gua/gods/containers"
+import (
+	"errors"
+
+	"github.com/uncle-gua/gods/containers"
+)
+
+// ErrUnsorted is returned when building a tree from keys that are not in strictly increasing order.
+var ErrUnsorted = errors.New("trees: keys are not in strictly increasing order")
 
 // Tree interface that all trees implement
 type Tree interface {
@@ -0,0 +1,127 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import "reflect"
+
+// Reverse returns a comparator that imposes the reverse ordering of the given comparator.
+func Reverse(comparator Comparator) Comparator {
+	return func(a, b interface{}) int {
+		return comparator(b, a)
+	}
+}
+
+// ThenComparing returns a lexicographic-order comparator: elements are compared with the first comparator,
+// and only if they are equal with respect to it, with the next one, and so on.
+// Elements are considered equal only if all comparators consider them equal.
+func ThenComparing(comparator Comparator, others ...Comparator) Comparator {
+	return func(a, b interface{}) int {
+		if result := comparator(a, b); result != 0 {
+			return result
+		}
+		for _, other := range others {
+			if result := other(a, b); result != 0 {
+				return result
+			}
+		}
+		return 0
+	}
+}
+
+// By returns a comparator that compares elements by the key extracted with the given function,
+// using the given comparator to compare the extracted keys.
+//
+// Example, ordering users by name:
+//
+//	byName := utils.By(func(u interface{}) interface{} { return u.(User).name }, utils.StringComparator)
+func By(key func(element interface{}) interface{}, comparator Comparator) Comparator {
+	return func(a, b interface{}) int {
+		return comparator(key(a), key(b))
+	}
+}
+
+// NilsFirst returns a comparator that considers nil to be less than non-nil elements.
+// Two nils are equal and non-nil elements are compared with the given comparator.
+// Typed nils (nil pointers, maps, slices, etc. stored in an interface) are treated as nil.
+func NilsFirst(comparator Comparator) Comparator {
+	return nilsComparator(comparator, -1)
+}
+
+// NilsLast returns a comparator that considers nil to be greater than non-nil elements.
+// Two nils are equal and non-nil elements are compared with the given comparator.
+// Typed nils (nil pointers, maps, slices, etc. stored in an interface) are treated as nil.
+func NilsLast(comparator Comparator) Comparator {
+	return nilsComparator(comparator, 1)
+}
+
+// SliceComparator returns a comparator on []interface{} that compares slices lexicographically,
+// comparing elements pairwise with the given comparator.
+// If one slice is a prefix of the other, the shorter slice is the lesser one.
+func SliceComparator(comparator Comparator) Comparator {
+	return func(a, b interface{}) int {
+		s1 := a.([]interface{})
+		s2 := b.([]interface{})
+		for i := 0; i < len(s1) && i < len(s2); i++ {
+			if result := comparator(s1[i], s2[i]); result != 0 {
+				return result
+			}
+		}
+		switch {
+		case len(s1) > len(s2):
+			return 1
+		case len(s1) < len(s2):
+			return -1
+		default:
+			return 0
+		}
+	}
+}
+
+// TupleComparator returns a comparator on []interface{} tuples that compares them lexicographically,
+// comparing the i-th elements with the i-th comparator.
+// Tuples should have as many elements as there are comparators, otherwise method panics.
+//
+// Example, ordering (name, age) pairs by name and then by age:
+//
+//	byNameAndAge := utils.TupleComparator(utils.StringComparator, utils.IntComparator)
+func TupleComparator(comparators ...Comparator) Comparator {
+	return func(a, b interface{}) int {
+		t1 := a.([]interface{})
+		t2 := b.([]interface{})
+		for i, comparator := range comparators {
+			if result := comparator(t1[i], t2[i]); result != 0 {
+				return result
+			}
+		}
+		return 0
+	}
+}
+
+func nilsComparator(comparator Comparator, nilOrder int) Comparator {
+	return func(a, b interface{}) int {
+		aNil, bNil := isNil(a), isNil(b)
+		switch {
+		case aNil && bNil:
+			return 0
+		case aNil:
+			return nilOrder
+		case bNil:
+			return -nilOrder
+		default:
+			return comparator(a, b)
+		}
+	}
+}
+
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface, reflect.Func, reflect.Chan:
+		return v.IsNil()
+	}
+	return false
+}
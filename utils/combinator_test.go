@@ -0,0 +1,205 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"fmt"
+	"testing"
+)
+
+type user struct {
+	name string
+	age  int
+}
+
+func TestReverse(t *testing.T) {
+	comparator := Reverse(IntComparator)
+
+	// i1,i2,expected
+	tests := [][]interface{}{
+		{1, 1, 0},
+		{1, 2, 1},
+		{2, 1, -1},
+	}
+
+	for _, test := range tests {
+		actual := comparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+
+	values := []interface{}{3, 1, 4, 1, 5}
+	Sort(values, comparator)
+	assertSorted(t, values, comparator, []interface{}{5, 4, 3, 1, 1})
+}
+
+func TestThenComparing(t *testing.T) {
+	byAge := By(func(u interface{}) interface{} { return u.(user).age }, IntComparator)
+	byName := By(func(u interface{}) interface{} { return u.(user).name }, StringComparator)
+	comparator := ThenComparing(Reverse(byAge), byName)
+
+	// u1,u2,expected
+	tests := [][]interface{}{
+		{user{"a", 1}, user{"a", 1}, 0},
+		{user{"a", 2}, user{"a", 1}, -1},
+		{user{"a", 1}, user{"b", 1}, -1},
+		{user{"b", 1}, user{"a", 1}, 1},
+		{user{"b", 2}, user{"a", 1}, -1},
+	}
+
+	for _, test := range tests {
+		actual := comparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+
+	values := []interface{}{user{"c", 1}, user{"b", 2}, user{"a", 1}, user{"d", 2}}
+	Sort(values, comparator)
+	assertSorted(t, values, comparator, []interface{}{user{"b", 2}, user{"d", 2}, user{"a", 1}, user{"c", 1}})
+
+	if actual := ThenComparing(IntComparator)(1, 2); actual != -1 {
+		t.Errorf("Got %v expected %v", actual, -1)
+	}
+}
+
+func TestBy(t *testing.T) {
+	comparator := By(func(u interface{}) interface{} { return u.(user).name }, StringComparator)
+
+	if actual := comparator(user{"a", 2}, user{"a", 1}); actual != 0 {
+		t.Errorf("Got %v expected %v", actual, 0)
+	}
+	if actual := comparator(user{"a", 2}, user{"b", 1}); actual != -1 {
+		t.Errorf("Got %v expected %v", actual, -1)
+	}
+
+	values := []interface{}{user{"c", 1}, user{"a", 2}, user{"b", 3}}
+	Sort(values, comparator)
+	assertSorted(t, values, comparator, []interface{}{user{"a", 2}, user{"b", 3}, user{"c", 1}})
+}
+
+func TestNilsFirst(t *testing.T) {
+	comparator := NilsFirst(IntComparator)
+	var nilPointer *int
+
+	// i1,i2,expected
+	tests := [][]interface{}{
+		{nil, nil, 0},
+		{nil, 1, -1},
+		{1, nil, 1},
+		{1, 2, -1},
+		{nilPointer, nil, 0},
+		{nilPointer, 1, -1},
+	}
+
+	for _, test := range tests {
+		actual := comparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+
+	values := []interface{}{2, nil, 1, nil}
+	Sort(values, comparator)
+	assertSorted(t, values, comparator, []interface{}{nil, nil, 1, 2})
+}
+
+func TestNilsLast(t *testing.T) {
+	comparator := NilsLast(StringComparator)
+
+	// s1,s2,expected
+	tests := [][]interface{}{
+		{nil, nil, 0},
+		{nil, "a", 1},
+		{"a", nil, -1},
+		{"a", "b", -1},
+	}
+
+	for _, test := range tests {
+		actual := comparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+
+	values := []interface{}{nil, "b", nil, "a"}
+	Sort(values, comparator)
+	assertSorted(t, values, comparator, []interface{}{"a", "b", nil, nil})
+
+	values = []interface{}{nil, "b", nil, "a"}
+	comparator = Reverse(NilsLast(StringComparator))
+	Sort(values, comparator)
+	assertSorted(t, values, comparator, []interface{}{nil, nil, "b", "a"})
+}
+
+func TestSliceComparator(t *testing.T) {
+	comparator := SliceComparator(IntComparator)
+
+	// s1,s2,expected
+	tests := [][]interface{}{
+		{[]interface{}{}, []interface{}{}, 0},
+		{[]interface{}{1, 2}, []interface{}{1, 2}, 0},
+		{[]interface{}{1, 2}, []interface{}{1, 3}, -1},
+		{[]interface{}{2}, []interface{}{1, 3}, 1},
+		{[]interface{}{1}, []interface{}{1, 0}, -1},
+		{[]interface{}{1, 0}, []interface{}{1}, 1},
+		{[]interface{}{}, []interface{}{1}, -1},
+	}
+
+	for _, test := range tests {
+		actual := comparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+
+	values := []interface{}{[]interface{}{2}, []interface{}{1, 2}, []interface{}{}, []interface{}{1}}
+	Sort(values, comparator)
+	assertSorted(t, values, comparator, []interface{}{[]interface{}{}, []interface{}{1}, []interface{}{1, 2}, []interface{}{2}})
+}
+
+func TestTupleComparator(t *testing.T) {
+	comparator := TupleComparator(StringComparator, Reverse(IntComparator))
+
+	// t1,t2,expected
+	tests := [][]interface{}{
+		{[]interface{}{"a", 1}, []interface{}{"a", 1}, 0},
+		{[]interface{}{"a", 1}, []interface{}{"b", 1}, -1},
+		{[]interface{}{"a", 1}, []interface{}{"a", 2}, 1},
+		{[]interface{}{"b", 2}, []interface{}{"a", 1}, 1},
+	}
+
+	for _, test := range tests {
+		actual := comparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+
+	values := []interface{}{[]interface{}{"b", 1}, []interface{}{"a", 1}, []interface{}{"a", 2}}
+	Sort(values, comparator)
+	assertSorted(t, values, comparator, []interface{}{[]interface{}{"a", 2}, []interface{}{"a", 1}, []interface{}{"b", 1}})
+}
+
+// assertSorted checks that the values sorted by Sort are in the expected order and in non-decreasing order
+// with respect to the comparator used for sorting.
+func assertSorted(t *testing.T, values []interface{}, comparator Comparator, expected []interface{}) {
+	t.Helper()
+	for i := 1; i < len(values); i++ {
+		if comparator(values[i-1], values[i]) > 0 {
+			t.Errorf("Not sorted at %v: %v > %v", i, values[i-1], values[i])
+		}
+	}
+	if actual, expected := fmt.Sprintf("%v", values), fmt.Sprintf("%v", expected); actual != expected {
+		t.Errorf("Got %v expected %v", actual, expected)
+	}
+}
@@ -4,15 +4,22 @@
 
 package utils
 
-import "time"
+import (
+	"bytes"
+	"math"
+	"math/big"
+	"net/netip"
+	"time"
+)
 
 // Comparator will make type assertion (see IntComparator for example),
 // which will panic if a or b are not of the asserted type.
 //
 // Should return a number:
-//    negative , if a < b
-//    zero     , if a == b
-//    positive , if a > b
+//
+//	negative , if a < b
+//	zero     , if a == b
+//	positive , if a > b
 type Comparator func(a, b interface{}) int
 
 // StringComparator provides a fast comparison on strings
@@ -180,6 +187,7 @@ func UInt64Comparator(a, b interface{}) int {
 }
 
 // Float32Comparator provides a basic comparison on float32
+// NaN is neither less nor greater than any value, use Float32TotalComparator for a total order.
 func Float32Comparator(a, b interface{}) int {
 	aAsserted := a.(float32)
 	bAsserted := b.(float32)
@@ -194,6 +202,7 @@ func Float32Comparator(a, b interface{}) int {
 }
 
 // Float64Comparator provides a basic comparison on float64
+// NaN is neither less nor greater than any value, use Float64TotalComparator for a total order.
 func Float64Comparator(a, b interface{}) int {
 	aAsserted := a.(float64)
 	bAsserted := b.(float64)
@@ -249,3 +258,108 @@ func TimeComparator(a, b interface{}) int {
 		return 0
 	}
 }
+
+// Float32TotalComparator provides a total order on float32:
+// -Inf < negative values < -0 < +0 < positive values < +Inf < NaN, with all NaNs being equal.
+func Float32TotalComparator(a, b interface{}) int {
+	return floatTotalOrder(float64(a.(float32)), float64(b.(float32)))
+}
+
+// Float64TotalComparator provides a total order on float64:
+// -Inf < negative values < -0 < +0 < positive values < +Inf < NaN, with all NaNs being equal.
+func Float64TotalComparator(a, b interface{}) int {
+	return floatTotalOrder(a.(float64), b.(float64))
+}
+
+// BytesComparator provides a lexicographic comparison on []byte
+func BytesComparator(a, b interface{}) int {
+	return bytes.Compare(a.([]byte), b.([]byte))
+}
+
+// BoolComparator provides a basic comparison on bool (false < true)
+func BoolComparator(a, b interface{}) int {
+	aAsserted := a.(bool)
+	bAsserted := b.(bool)
+	switch {
+	case aAsserted && !bAsserted:
+		return 1
+	case !aAsserted && bAsserted:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// BigIntComparator provides a basic comparison on *big.Int
+func BigIntComparator(a, b interface{}) int {
+	return a.(*big.Int).Cmp(b.(*big.Int))
+}
+
+// BigFloatComparator provides a basic comparison on *big.Float (-0 and +0 are equal)
+func BigFloatComparator(a, b interface{}) int {
+	return a.(*big.Float).Cmp(b.(*big.Float))
+}
+
+// DurationComparator provides a basic comparison on time.Duration
+func DurationComparator(a, b interface{}) int {
+	aAsserted := a.(time.Duration)
+	bAsserted := b.(time.Duration)
+	switch {
+	case aAsserted > bAsserted:
+		return 1
+	case aAsserted < bAsserted:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// AddrComparator provides a basic comparison on netip.Addr
+// (the zero Addr < IPv4 addresses < IPv6 addresses, then by address and zone).
+func AddrComparator(a, b interface{}) int {
+	return a.(netip.Addr).Compare(b.(netip.Addr))
+}
+
+// PrefixComparator provides a basic comparison on netip.Prefix
+// (by address as in AddrComparator, then by prefix length).
+func PrefixComparator(a, b interface{}) int {
+	aAsserted := a.(netip.Prefix)
+	bAsserted := b.(netip.Prefix)
+	if diff := aAsserted.Addr().Compare(bAsserted.Addr()); diff != 0 {
+		return diff
+	}
+	switch {
+	case aAsserted.Bits() > bAsserted.Bits():
+		return 1
+	case aAsserted.Bits() < bAsserted.Bits():
+		return -1
+	default:
+		return 0
+	}
+}
+
+func floatTotalOrder(x, y float64) int {
+	xNaN, yNaN := math.IsNaN(x), math.IsNaN(y)
+	switch {
+	case xNaN && yNaN:
+		return 0
+	case xNaN:
+		return 1
+	case yNaN:
+		return -1
+	case x > y:
+		return 1
+	case x < y:
+		return -1
+	}
+	// numerically equal, only zeros can differ in sign
+	xNegative, yNegative := math.Signbit(x), math.Signbit(y)
+	switch {
+	case !xNegative && yNegative:
+		return 1
+	case xNegative && !yNegative:
+		return -1
+	default:
+		return 0
+	}
+}
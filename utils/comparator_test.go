@@ -5,6 +5,9 @@
 package utils
 
 import (
+	"math"
+	"math/big"
+	"net/netip"
 	"testing"
 	"time"
 )
@@ -305,3 +308,179 @@ func TestRuneComparator(t *testing.T) {
 		}
 	}
 }
+
+func TestFloat32TotalComparator(t *testing.T) {
+	nan := float32(math.NaN())
+	negativeZero := float32(math.Copysign(0, -1))
+	tests := [][]interface{}{
+		{float32(1.1), float32(1.1), 0},
+		{float32(0.1), float32(1.1), -1},
+		{float32(1.1), float32(0.1), 1},
+		{nan, nan, 0},
+		{nan, float32(math.Inf(1)), 1},
+		{float32(math.Inf(1)), nan, -1},
+		{negativeZero, float32(0), -1},
+		{float32(0), negativeZero, 1},
+		{negativeZero, negativeZero, 0},
+	}
+	for _, test := range tests {
+		actual := Float32TotalComparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v for %v and %v", actual, expected, test[0], test[1])
+		}
+	}
+}
+
+func TestFloat64TotalComparator(t *testing.T) {
+	nan := math.NaN()
+	negativeZero := math.Copysign(0, -1)
+	tests := [][]interface{}{
+		{1.1, 1.1, 0},
+		{0.1, 1.1, -1},
+		{1.1, 0.1, 1},
+		{nan, nan, 0},
+		{nan, math.Copysign(nan, -1), 0},
+		{nan, math.Inf(1), 1},
+		{math.Inf(-1), nan, -1},
+		{math.Inf(-1), -math.MaxFloat64, -1},
+		{negativeZero, 0.0, -1},
+		{0.0, negativeZero, 1},
+		{-math.SmallestNonzeroFloat64, negativeZero, -1},
+		{math.SmallestNonzeroFloat64, 0.0, 1},
+	}
+	for _, test := range tests {
+		actual := Float64TotalComparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v for %v and %v", actual, expected, test[0], test[1])
+		}
+	}
+
+	values := []interface{}{nan, 1.0, math.Inf(-1), 0.0, nan, negativeZero, math.Inf(1), -1.0}
+	Sort(values, Float64TotalComparator)
+	for i := 1; i < len(values); i++ {
+		if Float64TotalComparator(values[i-1], values[i]) > 0 {
+			t.Errorf("Not sorted at %v: %v > %v", i, values[i-1], values[i])
+		}
+	}
+	if actualValue := values[2].(float64); actualValue != 0 || !math.Signbit(actualValue) {
+		t.Errorf("Got %v expected %v", actualValue, negativeZero)
+	}
+	if actualValue := values[7].(float64); !math.IsNaN(actualValue) {
+		t.Errorf("Got %v expected %v", actualValue, nan)
+	}
+}
+
+func TestBytesComparator(t *testing.T) {
+	tests := [][]interface{}{
+		{[]byte{}, []byte{}, 0},
+		{[]byte(nil), []byte{}, 0},
+		{[]byte{1, 2}, []byte{1, 2}, 0},
+		{[]byte{1, 2}, []byte{1, 3}, -1},
+		{[]byte{1, 2, 0}, []byte{1, 2}, 1},
+	}
+	for _, test := range tests {
+		actual := BytesComparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+}
+
+func TestBoolComparator(t *testing.T) {
+	tests := [][]interface{}{
+		{true, true, 0},
+		{false, false, 0},
+		{false, true, -1},
+		{true, false, 1},
+	}
+	for _, test := range tests {
+		actual := BoolComparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+}
+
+func TestBigIntComparator(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	tests := [][]interface{}{
+		{big.NewInt(1), big.NewInt(1), 0},
+		{big.NewInt(-1), big.NewInt(1), -1},
+		{huge, big.NewInt(1), 1},
+	}
+	for _, test := range tests {
+		actual := BigIntComparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+}
+
+func TestBigFloatComparator(t *testing.T) {
+	tests := [][]interface{}{
+		{big.NewFloat(1.5), big.NewFloat(1.5), 0},
+		{big.NewFloat(0.5), big.NewFloat(1.5), -1},
+		{big.NewFloat(math.Inf(1)), big.NewFloat(1.5), 1},
+		{big.NewFloat(math.Copysign(0, -1)), big.NewFloat(0), 0},
+	}
+	for _, test := range tests {
+		actual := BigFloatComparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+}
+
+func TestDurationComparator(t *testing.T) {
+	tests := [][]interface{}{
+		{time.Second, time.Second, 0},
+		{time.Millisecond, time.Second, -1},
+		{time.Hour, time.Second, 1},
+	}
+	for _, test := range tests {
+		actual := DurationComparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+}
+
+func TestAddrComparator(t *testing.T) {
+	tests := [][]interface{}{
+		{netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("10.0.0.1"), 0},
+		{netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("10.0.0.2"), -1},
+		{netip.MustParseAddr("10.0.0.10"), netip.MustParseAddr("10.0.0.9"), 1},
+		{netip.MustParseAddr("::1"), netip.MustParseAddr("10.0.0.1"), 1},
+		{netip.Addr{}, netip.MustParseAddr("0.0.0.0"), -1},
+	}
+	for _, test := range tests {
+		actual := AddrComparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+}
+
+func TestPrefixComparator(t *testing.T) {
+	tests := [][]interface{}{
+		{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("10.0.0.0/8"), 0},
+		{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("10.0.0.0/16"), -1},
+		{netip.MustParsePrefix("10.1.0.0/16"), netip.MustParsePrefix("10.0.0.0/8"), 1},
+		{netip.MustParsePrefix("::/0"), netip.MustParsePrefix("10.0.0.0/8"), 1},
+	}
+	for _, test := range tests {
+		actual := PrefixComparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+}
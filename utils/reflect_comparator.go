@@ -0,0 +1,226 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ComparatorTag is the struct tag key read by ReflectComparator.
+//
+// Supported tag values:
+//
+//	`gods:"-"`    field is skipped
+//	`gods:"asc"`  field is ordered in ascending order (default)
+//	`gods:"desc"` field is ordered in descending order
+const ComparatorTag = "gods"
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// valueComparator compares two values of the same type.
+type valueComparator func(x, y reflect.Value) int
+
+// ReflectComparator builds a comparator for values of the given type by reflection.
+//
+// Supported types and their ordering:
+//   - booleans (false < true), integers, strings (as in StringComparator)
+//   - floats in total order (as in Float64TotalComparator)
+//   - time.Time (as in TimeComparator), if reachable through exported fields only
+//   - structs field by field in declaration order, with fields tagged by ComparatorTag skipped or reversed
+//   - arrays and slices lexicographically (shorter prefix first, nil and empty slices are equal)
+//   - pointers by the values they point to (nil first)
+//
+// Returns an error if the type, or any type it is composed of, is not supported (e.g. maps, functions, channels,
+// interfaces, complex numbers) or if a struct tag is invalid.
+//
+// The returned comparator panics if called with values of a different type.
+//
+// Example:
+//
+//	type Key struct {
+//		Tenant string
+//		TS     int64  `gods:"desc"`
+//		Note   string `gods:"-"`
+//	}
+//	comparator, err := utils.ReflectComparator(reflect.TypeOf(Key{}))
+func ReflectComparator(keyType reflect.Type) (Comparator, error) {
+	if keyType == nil {
+		return nil, errors.New("utils: cannot build comparator for nil type")
+	}
+	builder := &comparatorBuilder{cache: make(map[builderKey]*valueComparator)}
+	compare, err := builder.build(keyType, true, keyType.String())
+	if err != nil {
+		return nil, err
+	}
+	return func(a, b interface{}) int {
+		x := reflect.ValueOf(a)
+		y := reflect.ValueOf(b)
+		if !x.IsValid() || !y.IsValid() || x.Type() != keyType || y.Type() != keyType {
+			panic(fmt.Sprintf("utils: comparator for %v called with %T and %T", keyType, a, b))
+		}
+		return compare(x, y)
+	}, nil
+}
+
+type builderKey struct {
+	typ      reflect.Type
+	exported bool
+}
+
+type comparatorBuilder struct {
+	cache map[builderKey]*valueComparator
+}
+
+// build returns the comparator for the type, path is used in error messages.
+// Exported tells whether values of the type are reachable through exported fields only.
+func (builder *comparatorBuilder) build(typ reflect.Type, exported bool, path string) (valueComparator, error) {
+	key := builderKey{typ, exported}
+	if compare, ok := builder.cache[key]; ok {
+		// type is being built or was already built (recursive types)
+		return func(x, y reflect.Value) int { return (*compare)(x, y) }, nil
+	}
+	compare := new(valueComparator)
+	builder.cache[key] = compare
+
+	var err error
+	switch typ.Kind() {
+	case reflect.Bool:
+		*compare = func(x, y reflect.Value) int {
+			return BoolComparator(x.Bool(), y.Bool())
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		*compare = func(x, y reflect.Value) int {
+			return Int64Comparator(x.Int(), y.Int())
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		*compare = func(x, y reflect.Value) int {
+			return UInt64Comparator(x.Uint(), y.Uint())
+		}
+	case reflect.Float32, reflect.Float64:
+		*compare = func(x, y reflect.Value) int {
+			return floatTotalOrder(x.Float(), y.Float())
+		}
+	case reflect.String:
+		*compare = func(x, y reflect.Value) int {
+			return StringComparator(x.String(), y.String())
+		}
+	case reflect.Array:
+		*compare, err = builder.buildArray(typ, exported, path)
+	case reflect.Slice:
+		*compare, err = builder.buildSlice(typ, exported, path)
+	case reflect.Ptr:
+		*compare, err = builder.buildPointer(typ, exported, path)
+	case reflect.Struct:
+		*compare, err = builder.buildStruct(typ, exported, path)
+	default:
+		err = fmt.Errorf("utils: cannot compare values of type %v (%v)", typ, path)
+	}
+	if err != nil {
+		delete(builder.cache, key)
+		return nil, err
+	}
+	return *compare, nil
+}
+
+func (builder *comparatorBuilder) buildArray(typ reflect.Type, exported bool, path string) (valueComparator, error) {
+	compareElements, err := builder.build(typ.Elem(), exported, path+"[]")
+	if err != nil {
+		return nil, err
+	}
+	return func(x, y reflect.Value) int {
+		for i := 0; i < x.Len(); i++ {
+			if result := compareElements(x.Index(i), y.Index(i)); result != 0 {
+				return result
+			}
+		}
+		return 0
+	}, nil
+}
+
+func (builder *comparatorBuilder) buildSlice(typ reflect.Type, exported bool, path string) (valueComparator, error) {
+	compareElements, err := builder.build(typ.Elem(), exported, path+"[]")
+	if err != nil {
+		return nil, err
+	}
+	return func(x, y reflect.Value) int {
+		for i := 0; i < x.Len() && i < y.Len(); i++ {
+			if result := compareElements(x.Index(i), y.Index(i)); result != 0 {
+				return result
+			}
+		}
+		return IntComparator(x.Len(), y.Len())
+	}, nil
+}
+
+func (builder *comparatorBuilder) buildPointer(typ reflect.Type, exported bool, path string) (valueComparator, error) {
+	compareElements, err := builder.build(typ.Elem(), exported, "*"+path)
+	if err != nil {
+		return nil, err
+	}
+	return func(x, y reflect.Value) int {
+		switch {
+		case x.IsNil() && y.IsNil():
+			return 0
+		case x.IsNil():
+			return -1
+		case y.IsNil():
+			return 1
+		default:
+			return compareElements(x.Elem(), y.Elem())
+		}
+	}, nil
+}
+
+func (builder *comparatorBuilder) buildStruct(typ reflect.Type, exported bool, path string) (valueComparator, error) {
+	if typ == timeType {
+		if !exported {
+			return nil, fmt.Errorf("utils: cannot compare values of type %v in unexported field (%v)", typ, path)
+		}
+		return func(x, y reflect.Value) int {
+			return TimeComparator(x.Interface(), y.Interface())
+		}, nil
+	}
+
+	type field struct {
+		index   int
+		compare valueComparator
+		reverse bool
+	}
+	var fields []field
+	for i := 0; i < typ.NumField(); i++ {
+		structField := typ.Field(i)
+		fieldPath := path + "." + structField.Name
+		reverse := false
+		switch tag := structField.Tag.Get(ComparatorTag); tag {
+		case "-":
+			continue
+		case "", "asc":
+		case "desc":
+			reverse = true
+		default:
+			return nil, fmt.Errorf("utils: invalid %v tag %q (%v)", ComparatorTag, tag, fieldPath)
+		}
+		compare, err := builder.build(structField.Type, exported && structField.PkgPath == "", fieldPath)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field{index: i, compare: compare, reverse: reverse})
+	}
+	return func(x, y reflect.Value) int {
+		for _, field := range fields {
+			result := field.compare(x.Field(field.index), y.Field(field.index))
+			if field.reverse {
+				result = -result
+			}
+			if result != 0 {
+				return result
+			}
+		}
+		return 0
+	}, nil
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReflectComparatorStruct(t *testing.T) {
+	type key struct {
+		tenant string
+		ts     int64  `gods:"desc"`
+		note   string `gods:"-"`
+	}
+
+	comparator, err := ReflectComparator(reflect.TypeOf(key{}))
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+
+	// k1,k2,expected
+	tests := [][]interface{}{
+		{key{"a", 1, "x"}, key{"a", 1, "y"}, 0},
+		{key{"a", 1, ""}, key{"b", 1, ""}, -1},
+		{key{"b", 1, ""}, key{"a", 9, ""}, 1},
+		{key{"a", 2, ""}, key{"a", 1, ""}, -1},
+		{key{"a", 1, ""}, key{"a", 2, ""}, 1},
+	}
+
+	for _, test := range tests {
+		actual := comparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v for %v and %v", actual, expected, test[0], test[1])
+		}
+	}
+
+	values := []interface{}{key{"b", 1, ""}, key{"a", 1, ""}, key{"a", 3, ""}, key{"b", 2, ""}}
+	Sort(values, comparator)
+	assertSorted(t, values, comparator, []interface{}{key{"a", 3, ""}, key{"a", 1, ""}, key{"b", 2, ""}, key{"b", 1, ""}})
+}
+
+func TestReflectComparatorArraysAndSlices(t *testing.T) {
+	comparator, err := ReflectComparator(reflect.TypeOf([2]int{}))
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if actual := comparator([2]int{1, 2}, [2]int{1, 3}); actual != -1 {
+		t.Errorf("Got %v expected %v", actual, -1)
+	}
+	if actual := comparator([2]int{2, 0}, [2]int{1, 3}); actual != 1 {
+		t.Errorf("Got %v expected %v", actual, 1)
+	}
+
+	comparator, err = ReflectComparator(reflect.TypeOf([]string{}))
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+
+	// s1,s2,expected
+	tests := [][]interface{}{
+		{[]string(nil), []string{}, 0},
+		{[]string{"a"}, []string{"a"}, 0},
+		{[]string{"a"}, []string{"a", "b"}, -1},
+		{[]string{"b"}, []string{"a", "b"}, 1},
+	}
+
+	for _, test := range tests {
+		actual := comparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v for %v and %v", actual, expected, test[0], test[1])
+		}
+	}
+}
+
+func TestReflectComparatorBasicTypes(t *testing.T) {
+	type Event struct {
+		At      time.Time
+		Score   float64
+		Flag    bool
+		Counter uint8
+		Next    *Event
+	}
+
+	comparator, err := ReflectComparator(reflect.TypeOf(Event{}))
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+
+	now := time.Now()
+
+	// e1,e2,expected
+	tests := [][]interface{}{
+		{Event{At: now}, Event{At: now}, 0},
+		{Event{At: now}, Event{At: now.Add(time.Second)}, -1},
+		{Event{At: now, Score: math.NaN()}, Event{At: now, Score: math.Inf(1)}, 1},
+		{Event{At: now, Flag: false}, Event{At: now, Flag: true}, -1},
+		{Event{At: now, Counter: 2}, Event{At: now, Counter: 1}, 1},
+		{Event{At: now}, Event{At: now, Next: &Event{}}, -1},
+		{Event{At: now, Next: &Event{Counter: 1}}, Event{At: now, Next: &Event{}}, 1},
+	}
+
+	for _, test := range tests {
+		actual := comparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+}
+
+func TestReflectComparatorErrors(t *testing.T) {
+	type withMap struct {
+		Name string
+		Tags map[string]string
+	}
+	type withTag struct {
+		Name string `gods:"descending"`
+	}
+	type withUnexportedTime struct {
+		at time.Time
+	}
+
+	tests := []struct {
+		typ      reflect.Type
+		contains string
+	}{
+		{reflect.TypeOf(withMap{}), "withMap.Tags"},
+		{reflect.TypeOf(withTag{}), "descending"},
+		{reflect.TypeOf(withUnexportedTime{}), "withUnexportedTime.at"},
+		{reflect.TypeOf([]interface{}{}), "interface"},
+		{reflect.TypeOf(complex(1, 1)), "complex128"},
+		{reflect.TypeOf(func() {}), "func"},
+		{nil, "nil"},
+	}
+
+	for _, test := range tests {
+		comparator, err := ReflectComparator(test.typ)
+		if err == nil || comparator != nil {
+			t.Errorf("Expected error for %v", test.typ)
+			continue
+		}
+		if !strings.Contains(err.Error(), test.contains) {
+			t.Errorf("Got %v expected error containing %v", err, test.contains)
+		}
+	}
+}
+
+func TestReflectComparatorWrongType(t *testing.T) {
+	comparator, err := ReflectComparator(reflect.TypeOf(0))
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	comparator(1, "a")
+}
@@ -4,7 +4,14 @@
 
 package utils
 
-import "sort"
+import (
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// parallelSortThreshold is the size of the slices below which ParallelSort does not split the work any further.
+const parallelSortThreshold = 1 << 12
 
 // Sort sorts values (in-place) with respect to the given comparator.
 //
@@ -13,6 +20,111 @@ func Sort(values []interface{}, comparator Comparator) {
 	sort.Sort(sortable{values, comparator})
 }
 
+// SortStable sorts values (in-place) with respect to the given comparator,
+// keeping the original order of equal elements.
+//
+// Uses Go's stable sort (insertion sort of blocks merged with symmerge).
+func SortStable(values []interface{}, comparator Comparator) {
+	sort.Stable(sortable{values, comparator})
+}
+
+// PartialSort rearranges values (in-place) so that the first k values are the k smallest values
+// with respect to the given comparator, in sorted order. The order of the remaining values is unspecified.
+// If k is not smaller than the number of values, all values are sorted.
+//
+// Uses a bounded max-heap, i.e. O(n*log(k)) comparisons.
+func PartialSort(values []interface{}, k int, comparator Comparator) {
+	if k <= 0 {
+		return
+	}
+	if k >= len(values) {
+		Sort(values, comparator)
+		return
+	}
+	// max-heap of the k smallest values seen so far
+	top := sortable{values[:k], Reverse(comparator)}
+	for i := k/2 - 1; i >= 0; i-- {
+		siftDown(top, i)
+	}
+	for i := k; i < len(values); i++ {
+		if comparator(values[i], values[0]) < 0 {
+			values[0], values[i] = values[i], values[0]
+			siftDown(top, 0)
+		}
+	}
+	Sort(values[:k], comparator)
+}
+
+// ParallelSort sorts values (in-place) with respect to the given comparator,
+// keeping the original order of equal elements.
+//
+// Uses a merge sort that sorts the halves of large slices concurrently (up to GOMAXPROCS goroutines),
+// so the comparator must be safe for concurrent use. Small slices are sorted as in SortStable.
+func ParallelSort(values []interface{}, comparator Comparator) {
+	if len(values) <= parallelSortThreshold {
+		SortStable(values, comparator)
+		return
+	}
+	depth := 0
+	for procs := 1; procs < runtime.GOMAXPROCS(0); procs *= 2 {
+		depth++
+	}
+	parallelMergeSort(values, make([]interface{}, len(values)), comparator, depth)
+}
+
+func parallelMergeSort(values []interface{}, buffer []interface{}, comparator Comparator, depth int) {
+	if depth <= 0 || len(values) <= parallelSortThreshold {
+		SortStable(values, comparator)
+		return
+	}
+	middle := len(values) / 2
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		parallelMergeSort(values[:middle], buffer[:middle], comparator, depth-1)
+	}()
+	parallelMergeSort(values[middle:], buffer[middle:], comparator, depth-1)
+	wg.Wait()
+	merge(values, middle, buffer, comparator)
+}
+
+// merge merges the sorted values[:middle] and values[middle:] (in-place) using the buffer of the same size.
+func merge(values []interface{}, middle int, buffer []interface{}, comparator Comparator) {
+	copy(buffer, values)
+	i, j, k := 0, middle, 0
+	for i < middle && j < len(values) {
+		// take from the right only if strictly smaller to keep the sort stable
+		if comparator(buffer[j], buffer[i]) < 0 {
+			values[k] = buffer[j]
+			j++
+		} else {
+			values[k] = buffer[i]
+			i++
+		}
+		k++
+	}
+	k += copy(values[k:], buffer[i:middle])
+	copy(values[k:], buffer[j:])
+}
+
+func siftDown(heap sortable, i int) {
+	for {
+		child := 2*i + 1
+		if child >= heap.Len() {
+			return
+		}
+		if right := child + 1; right < heap.Len() && heap.Less(right, child) {
+			child = right
+		}
+		if !heap.Less(child, i) {
+			return
+		}
+		heap.Swap(i, child)
+		i = child
+	}
+}
+
 type sortable struct {
 	values     []interface{}
 	comparator Comparator
@@ -5,7 +5,9 @@
 package utils
 
 import (
+	"fmt"
 	"math/rand"
+	"strconv"
 	"testing"
 )
 
@@ -92,6 +94,92 @@ func TestSortRandom(t *testing.T) {
 	}
 }
 
+func TestSortStable(t *testing.T) {
+	type User struct {
+		id   int
+		name string
+	}
+	byID := By(func(u interface{}) interface{} { return u.(User).id }, IntComparator)
+
+	users := []interface{}{}
+	for i := 0; i < 1000; i++ {
+		users = append(users, User{rand.Intn(10), strconv.Itoa(i)})
+	}
+	expected := make([]interface{}, len(users))
+	copy(expected, users)
+	// reference stable sort (insertion sort)
+	for i := 1; i < len(expected); i++ {
+		for j := i; j > 0 && byID(expected[j-1], expected[j]) > 0; j-- {
+			expected[j-1], expected[j] = expected[j], expected[j-1]
+		}
+	}
+
+	SortStable(users, byID)
+
+	for i := range users {
+		if users[i] != expected[i] {
+			t.Fatalf("Got %v expected %v at %v", users[i], expected[i], i)
+		}
+	}
+}
+
+func TestPartialSort(t *testing.T) {
+	for _, k := range []int{-1, 0, 1, 5, 99, 100, 101} {
+		ints := []interface{}{}
+		for i := 0; i < 100; i++ {
+			ints = append(ints, rand.Intn(50))
+		}
+		sorted := make([]interface{}, len(ints))
+		copy(sorted, ints)
+		Sort(sorted, IntComparator)
+
+		PartialSort(ints, k, IntComparator)
+
+		for i := 0; i < k && i < len(ints); i++ {
+			if ints[i] != sorted[i] {
+				t.Errorf("Got %v expected %v at %v for k=%v", ints[i], sorted[i], i, k)
+			}
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", sortedCopy(ints)), fmt.Sprintf("%v", sorted); actualValue != expectedValue {
+			t.Errorf("Lost values for k=%v", k)
+		}
+	}
+}
+
+func TestParallelSort(t *testing.T) {
+	type User struct {
+		id   int
+		name string
+	}
+	byID := By(func(u interface{}) interface{} { return u.(User).id }, IntComparator)
+
+	for _, size := range []int{0, 1, 10, parallelSortThreshold + 1, 10 * parallelSortThreshold} {
+		users := []interface{}{}
+		for i := 0; i < size; i++ {
+			users = append(users, User{rand.Intn(100), strconv.Itoa(i)})
+		}
+		expected := make([]interface{}, len(users))
+		copy(expected, users)
+		SortStable(expected, byID)
+
+		ParallelSort(users, byID)
+
+		for i := range users {
+			if users[i] != expected[i] {
+				t.Fatalf("Got %v expected %v at %v for size %v", users[i], expected[i], i, size)
+			}
+		}
+	}
+}
+
+// sortedCopy returns a sorted copy of the ints.
+func sortedCopy(ints []interface{}) []interface{} {
+	sorted := make([]interface{}, len(ints))
+	copy(sorted, ints)
+	Sort(sorted, IntComparator)
+	return sorted
+}
+
 func BenchmarkGoSortRandom(b *testing.B) {
 	b.StopTimer()
 	ints := []interface{}{}
@@ -102,3 +190,36 @@ func BenchmarkGoSortRandom(b *testing.B) {
 	Sort(ints, IntComparator)
 	b.StopTimer()
 }
+
+func BenchmarkSortStableRandom(b *testing.B) {
+	b.StopTimer()
+	ints := []interface{}{}
+	for i := 0; i < 100000; i++ {
+		ints = append(ints, rand.Int())
+	}
+	b.StartTimer()
+	SortStable(ints, IntComparator)
+	b.StopTimer()
+}
+
+func BenchmarkParallelSortRandom(b *testing.B) {
+	b.StopTimer()
+	ints := []interface{}{}
+	for i := 0; i < 100000; i++ {
+		ints = append(ints, rand.Int())
+	}
+	b.StartTimer()
+	ParallelSort(ints, IntComparator)
+	b.StopTimer()
+}
+
+func BenchmarkPartialSortRandom(b *testing.B) {
+	b.StopTimer()
+	ints := []interface{}{}
+	for i := 0; i < 100000; i++ {
+		ints = append(ints, rand.Int())
+	}
+	b.StartTimer()
+	PartialSort(ints, 10, IntComparator)
+	b.StopTimer()
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NaturalStringComparator provides a natural (numeric-aware) comparison on strings,
+// i.e. runs of ASCII digits are compared by their numeric value, so that "file2" < "file10".
+//
+// Leading zeros do not affect the numeric value; strings that are equal in natural order
+// (e.g. "a01" and "a1") are ordered by StringComparator, so that only identical strings are equal.
+func NaturalStringComparator(a, b interface{}) int {
+	s1 := a.(string)
+	s2 := b.(string)
+	i, j := 0, 0
+	for i < len(s1) && j < len(s2) {
+		c1, c2 := s1[i], s2[j]
+		if isDigit(c1) && isDigit(c2) {
+			var diff int
+			if diff, i, j = compareDigits(s1, s2, i, j); diff != 0 {
+				return diff
+			}
+			continue
+		}
+		if c1 != c2 {
+			return sign(int(c1) - int(c2))
+		}
+		i++
+		j++
+	}
+	switch {
+	case i < len(s1):
+		return 1
+	case j < len(s2):
+		return -1
+	default:
+		return StringComparator(s1, s2)
+	}
+}
+
+// compareDigits compares the runs of digits starting at s1[i] and s2[j] by their numeric value
+// and returns the result along with the indexes just past the runs.
+func compareDigits(s1, s2 string, i, j int) (int, int, int) {
+	for i < len(s1) && s1[i] == '0' {
+		i++
+	}
+	for j < len(s2) && s2[j] == '0' {
+		j++
+	}
+	start1, start2 := i, j
+	for i < len(s1) && isDigit(s1[i]) {
+		i++
+	}
+	for j < len(s2) && isDigit(s2[j]) {
+		j++
+	}
+	// longer number (without leading zeros) is the greater one
+	if diff := (i - start1) - (j - start2); diff != 0 {
+		return sign(diff), i, j
+	}
+	return StringComparator(s1[start1:i], s2[start2:j]), i, j
+}
+
+// CaseInsensitiveStringComparator provides a case-insensitive comparison on strings using Unicode simple case folding,
+// i.e. "apple" and "Apple" are equal and both sort before "banana".
+//
+// Strings that differ only in case are equal, so only one of them is kept by a tree-based map or set.
+// To keep them all while sorting them next to each other, combine it with StringComparator:
+//
+//	utils.ThenComparing(utils.CaseInsensitiveStringComparator, utils.StringComparator)
+func CaseInsensitiveStringComparator(a, b interface{}) int {
+	s1 := a.(string)
+	s2 := b.(string)
+	for len(s1) > 0 && len(s2) > 0 {
+		r1, size1 := utf8.DecodeRuneInString(s1)
+		r2, size2 := utf8.DecodeRuneInString(s2)
+		if r1 != r2 {
+			if diff := int(foldRune(r1)) - int(foldRune(r2)); diff != 0 {
+				return sign(diff)
+			}
+		}
+		s1 = s1[size1:]
+		s2 = s2[size2:]
+	}
+	return sign(len(s1) - len(s2))
+}
+
+// NormalizedStringComparator provides a comparison on strings that is aware of Unicode canonical equivalence,
+// i.e. strings are compared by code points of their canonical decomposition (NFD),
+// so that precomposed "é" and decomposed "é" are equal.
+//
+// Decomposition covers only the Latin, Greek and Cyrillic scripts along with the combining diacritical marks
+// (U+0080–U+052F and U+1E00–U+1FFF). Characters of other scripts, e.g. Hangul syllables or Devanagari letters
+// with nukta, are compared by their code points as they are, so strings in those scripts should be normalized
+// beforehand, e.g. with golang.org/x/text/unicode/norm.
+func NormalizedStringComparator(a, b interface{}) int {
+	s1 := a.(string)
+	s2 := b.(string)
+	if isASCII(s1) && isASCII(s2) {
+		return StringComparator(s1, s2)
+	}
+	r1 := decompose(s1)
+	r2 := decompose(s2)
+	for i := 0; i < len(r1) && i < len(r2); i++ {
+		if r1[i] != r2[i] {
+			return sign(int(r1[i]) - int(r2[i]))
+		}
+	}
+	return sign(len(r1) - len(r2))
+}
+
+// decompose returns the canonical decomposition of the string with combining marks in canonical order.
+func decompose(s string) []rune {
+	runes := make([]rune, 0, len(s))
+	for _, r := range s {
+		if decomposition, ok := decompositions[r]; ok {
+			runes = append(runes, []rune(decomposition)...)
+		} else {
+			runes = append(runes, r)
+		}
+	}
+	// canonical ordering: sort each run of combining marks by combining class (stable)
+	for i := 0; i < len(runes); {
+		if combiningClasses[runes[i]] == 0 {
+			i++
+			continue
+		}
+		j := i
+		for j < len(runes) && combiningClasses[runes[j]] != 0 {
+			j++
+		}
+		marks := runes[i:j]
+		sort.SliceStable(marks, func(x, y int) bool {
+			return combiningClasses[marks[x]] < combiningClasses[marks[y]]
+		})
+		i = j
+	}
+	return runes
+}
+
+// foldRune returns the smallest rune in the simple case folding orbit of the rune,
+// so that all case variants of a letter map to the same rune.
+func foldRune(r rune) rune {
+	min := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < min {
+			min = f
+		}
+	}
+	return min
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+func sign(diff int) int {
+	switch {
+	case diff > 0:
+		return 1
+	case diff < 0:
+		return -1
+	default:
+		return 0
+	}
+}
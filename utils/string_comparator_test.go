@@ -0,0 +1,113 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import "testing"
+
+func TestNaturalStringComparator(t *testing.T) {
+
+	// s1,s2,expected
+	tests := [][]interface{}{
+		{"", "", 0},
+		{"", "a", -1},
+		{"a", "", 1},
+		{"file2", "file10", -1},
+		{"file10", "file2", 1},
+		{"file10", "file10", 0},
+		{"file10a", "file10b", -1},
+		{"file010", "file9", 1},
+		{"a01", "a1", -1},
+		{"a1", "a01", 1},
+		{"a1b2", "a1b10", -1},
+		{"x", "10", 1},
+		{"10", "9x", 1},
+		{"img12.png", "img12.png", 0},
+		{"123456789012345678901234567890", "123456789012345678901234567891", -1},
+	}
+
+	for _, test := range tests {
+		actual := NaturalStringComparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v for %v and %v", actual, expected, test[0], test[1])
+		}
+	}
+
+	values := []interface{}{"file10", "file2", "file1", "file20", "file02"}
+	Sort(values, NaturalStringComparator)
+	assertSorted(t, values, NaturalStringComparator, []interface{}{"file1", "file02", "file2", "file10", "file20"})
+}
+
+func TestCaseInsensitiveStringComparator(t *testing.T) {
+
+	// s1,s2,expected
+	tests := [][]interface{}{
+		{"", "", 0},
+		{"apple", "Apple", 0},
+		{"APPLE", "apple", 0},
+		{"Apple", "banana", -1},
+		{"apple", "Banana", -1},
+		{"Zebra", "apple", 1},
+		{"apple", "apples", -1},
+		{"straße", "STRASSE", 1},
+		{"Σίσυφος", "ΣΊΣΥΦΟΣ", 0},
+		{"ſ", "S", 0},
+	}
+
+	for _, test := range tests {
+		actual := CaseInsensitiveStringComparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v for %v and %v", actual, expected, test[0], test[1])
+		}
+	}
+
+	comparator := ThenComparing(CaseInsensitiveStringComparator, StringComparator)
+	values := []interface{}{"banana", "apple", "Cherry", "Apple"}
+	Sort(values, comparator)
+	assertSorted(t, values, comparator, []interface{}{"Apple", "apple", "banana", "Cherry"})
+}
+
+func TestNormalizedStringComparator(t *testing.T) {
+
+	// s1,s2,expected
+	tests := [][]interface{}{
+		{"", "", 0},
+		{"abc", "abd", -1},
+		{"caf\u00e9", "cafe\u0301", 0},
+		{"cafe\u0301", "caf\u00e9", 0},
+		{"caf\u00e9", "cafe", 1},
+		{"caf\u00e9", "caff", -1},
+		{"\u1ec7", "e\u0323\u0302", 0}, // ệ precomposed and decomposed
+		{"\u1ec7", "e\u0302\u0323", 0}, // marks in non-canonical order
+		{"\u00ea\u0323", "e\u0323\u0302", 0},
+		{"\u00c5", "A\u030a", 0},
+		{"\u00c5", "\u00c4", 1},
+		{"a\u0341", "\u00e1", 0},      // singleton decomposition of a combining mark
+		{"\u03b9\u0344", "\u0390", 0}, // ΐ with the combining dialytika tonos
+	}
+
+	for _, test := range tests {
+		actual := NormalizedStringComparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v for %q and %q", actual, expected, test[0], test[1])
+		}
+	}
+
+	values := []interface{}{"cafés", "cafe", "café", "cafes"}
+	Sort(values, NormalizedStringComparator)
+	for i := 1; i < len(values); i++ {
+		if NormalizedStringComparator(values[i-1], values[i]) > 0 {
+			t.Errorf("Not sorted at %v: %q > %q", i, values[i-1], values[i])
+		}
+	}
+	if actualValue, expectedValue := values[0], "cafe"; actualValue != expectedValue {
+		t.Errorf("Got %q expected %q", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := values[3], "cafés"; actualValue != expectedValue {
+		t.Errorf("Got %q expected %q", actualValue, expectedValue)
+	}
+}
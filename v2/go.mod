@@ -0,0 +1,3 @@
+module github.com/uncle-gua/gods/v2
+
+go 1.21
@@ -0,0 +1,83 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package singlylinkedlist
+
+import "github.com/uncle-gua/gods/v2/containers"
+
+// Assert Iterator implementation
+var _ containers.IteratorWithIndex[int] = (*Iterator[int])(nil)
+
+// Iterator holding the iterator's state
+type Iterator[T comparable] struct {
+	list    *List[T]
+	index   int
+	element *element[T]
+}
+
+// Iterator returns a stateful iterator whose values can be fetched by an index.
+func (list *List[T]) Iterator() Iterator[T] {
+	return Iterator[T]{list: list, index: -1, element: nil}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's index and value can be retrieved by Index() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) Next() bool {
+	if iterator.index < iterator.list.size {
+		iterator.index++
+	}
+	if !iterator.list.withinRange(iterator.index) {
+		iterator.element = nil
+		return false
+	}
+	if iterator.index == 0 {
+		iterator.element = iterator.list.first
+	} else {
+		iterator.element = iterator.element.next
+	}
+	return true
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T]) Value() T {
+	return iterator.element.value
+}
+
+// Index returns the current element's index.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T]) Index() int {
+	return iterator.index
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator[T]) Begin() {
+	iterator.index = -1
+	iterator.element = nil
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If NextTo() returns true, then next element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) NextTo(f func(index int, value T) bool) bool {
+	for iterator.Next() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
+}
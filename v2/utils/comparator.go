@@ -0,0 +1,29 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import "time"
+
+// Comparator compares two values of the same type.
+// Ordered types can use cmp.Compare directly, see TimeComparator for a custom example.
+//
+// Should return a number:
+//
+//	negative , if x < y
+//	zero     , if x == y
+//	positive , if x > y
+type Comparator[T any] func(x, y T) int
+
+// TimeComparator provides a basic comparison on time.Time
+func TimeComparator(a, b time.Time) int {
+	switch {
+	case a.After(b):
+		return 1
+	case a.Before(b):
+		return -1
+	default:
+		return 0
+	}
+}
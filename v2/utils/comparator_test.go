@@ -0,0 +1,69 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeComparator(t *testing.T) {
+
+	now := time.Now()
+
+	// i1,i2,expected
+	tests := []struct {
+		t1, t2   time.Time
+		expected int
+	}{
+		{now, now, 0},
+		{now.Add(24 * 7 * 2 * time.Hour), now, 1},
+		{now, now.Add(24 * 7 * 2 * time.Hour), -1},
+	}
+
+	for _, test := range tests {
+		actual := TimeComparator(test.t1, test.t2)
+		if actual != test.expected {
+			t.Errorf("Got %v expected %v", actual, test.expected)
+		}
+	}
+}
+
+func TestCustomComparator(t *testing.T) {
+
+	type Custom struct {
+		id   int
+		name string
+	}
+
+	var byID Comparator[Custom] = func(c1, c2 Custom) int {
+		switch {
+		case c1.id > c2.id:
+			return 1
+		case c1.id < c2.id:
+			return -1
+		default:
+			return 0
+		}
+	}
+
+	// o1,o2,expected
+	tests := []struct {
+		c1, c2   Custom
+		expected int
+	}{
+		{Custom{1, "a"}, Custom{1, "a"}, 0},
+		{Custom{1, "a"}, Custom{2, "b"}, -1},
+		{Custom{2, "b"}, Custom{1, "a"}, 1},
+		{Custom{1, "a"}, Custom{1, "b"}, 0},
+	}
+
+	for _, test := range tests {
+		actual := byID(test.c1, test.c2)
+		if actual != test.expected {
+			t.Errorf("Got %v expected %v", actual, test.expected)
+		}
+	}
+}
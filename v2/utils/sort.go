@@ -0,0 +1,14 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import "slices"
+
+// Sort sorts values (in-place) with respect to the given comparator.
+//
+// Uses Go's slices.SortFunc (pattern-defeating quicksort).
+func Sort[T any](values []T, comparator Comparator[T]) {
+	slices.SortFunc(values, comparator)
+}